@@ -0,0 +1,31 @@
+package algorithm
+
+// Map returns a new array with the results of calling f on every element of in.
+func Map[T, U any](in []T, f func(T) U) []U {
+	out := make([]U, len(in))
+	for i, v := range in {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// Filter returns a new array with the elements of in which satisfy pred.
+func Filter[T any](in []T, pred func(T) bool) []T {
+	var out []T
+	for _, v := range in {
+		if pred(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Reduce applies f against an accumulator starting from init and each element of in
+// from left to right, and returns the final accumulator.
+func Reduce[T, U any](in []T, init U, f func(U, T) U) U {
+	acc := init
+	for _, v := range in {
+		acc = f(acc, v)
+	}
+	return acc
+}
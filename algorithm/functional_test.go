@@ -0,0 +1,44 @@
+package algorithm_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestMap(t *testing.T) {
+	got := algorithm.Map([]int{1, 2, 3}, strconv.Itoa)
+	if diff := gocmp.Diff([]string{"1", "2", "3"}, got); diff != "" {
+		t.Fatalf("unexpected result: %s", diff)
+	}
+	if got := algorithm.Map([]int{}, strconv.Itoa); len(got) != 0 {
+		t.Fatalf("expected an empty result but got %v", got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	got := algorithm.Filter([]int{1, 2, 3, 4, 6}, isEven)
+	if diff := gocmp.Diff([]int{2, 4, 6}, got); diff != "" {
+		t.Fatalf("unexpected result: %s", diff)
+	}
+	if got := algorithm.Filter([]int{1, 3}, isEven); len(got) != 0 {
+		t.Fatalf("expected an empty result but got %v", got)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := algorithm.Reduce([]int{1, 2, 3, 4}, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Fatalf("expected 10 but got %v", sum)
+	}
+	joined := algorithm.Reduce([]int{1, 2, 3}, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if joined != "123" {
+		t.Fatalf("expected 123 but got %v", joined)
+	}
+	if got := algorithm.Reduce([]int{}, 5, func(acc, v int) int { return acc + v }); got != 5 {
+		t.Fatalf("expected 5 but got %v", got)
+	}
+}
@@ -0,0 +1,74 @@
+package algorithm
+
+import "cmp"
+
+// MinFunc returns the minimum element of an array using less.
+// It returns false if the array is empty.
+func MinFunc[T any](values []T, less LessFunc[T]) (min T, ok bool) {
+	if len(values) == 0 {
+		return
+	}
+	min = values[0]
+	for _, v := range values[1:] {
+		if less(v, min) {
+			min = v
+		}
+	}
+	return min, true
+}
+
+// Min returns the minimum element of an array of values from ordered types.
+// It returns false if the array is empty.
+func Min[T cmp.Ordered](values []T) (T, bool) {
+	return MinFunc(values, cmp.Less[T])
+}
+
+// MaxFunc returns the maximum element of an array using less.
+// It returns false if the array is empty.
+func MaxFunc[T any](values []T, less LessFunc[T]) (max T, ok bool) {
+	if len(values) == 0 {
+		return
+	}
+	max = values[0]
+	for _, v := range values[1:] {
+		if less(max, v) {
+			max = v
+		}
+	}
+	return max, true
+}
+
+// Max returns the maximum element of an array of values from ordered types.
+// It returns false if the array is empty.
+func Max[T cmp.Ordered](values []T) (T, bool) {
+	return MaxFunc(values, cmp.Less[T])
+}
+
+// MinMaxFunc returns both the minimum and maximum elements of an array using less
+// in a single pass of roughly 1.5n comparisons. It returns false if the array is empty.
+func MinMaxFunc[T any](values []T, less LessFunc[T]) (min, max T, ok bool) {
+	if len(values) == 0 {
+		return
+	}
+	min, max = values[0], values[0]
+	// compare elements in pairs, so each pair costs 3 comparisons instead of 4.
+	for i := len(values) % 2; i+1 < len(values); i += 2 {
+		small, large := values[i], values[i+1]
+		if less(large, small) {
+			small, large = large, small
+		}
+		if less(small, min) {
+			min = small
+		}
+		if less(max, large) {
+			max = large
+		}
+	}
+	return min, max, true
+}
+
+// MinMax returns both the minimum and maximum elements of an array of values from ordered types.
+// It returns false if the array is empty.
+func MinMax[T cmp.Ordered](values []T) (min, max T, ok bool) {
+	return MinMaxFunc(values, cmp.Less[T])
+}
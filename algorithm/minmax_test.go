@@ -0,0 +1,79 @@
+package algorithm_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+)
+
+func TestMinMax(t *testing.T) {
+	testCases := map[string]struct {
+		input       []int
+		expectedMin int
+		expectedMax int
+		expectedOK  bool
+	}{
+		"should return false if the array is empty": {
+			input: []int{},
+		},
+		"should return the element if the array has a single element": {
+			input:       []int{2},
+			expectedMin: 2,
+			expectedMax: 2,
+			expectedOK:  true,
+		},
+		"should be correct if the array has an even length": {
+			input:       []int{3, 1, 4, 1, 5, 9},
+			expectedMin: 1,
+			expectedMax: 9,
+			expectedOK:  true,
+		},
+		"should be correct if the array has an odd length": {
+			input:       []int{3, 1, 4, 1, 5, 9, -2},
+			expectedMin: -2,
+			expectedMax: 9,
+			expectedOK:  true,
+		},
+		"should be correct if the extremes are at the edges": {
+			input:       []int{9, 4, 5, 0},
+			expectedMin: 0,
+			expectedMax: 9,
+			expectedOK:  true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if min, ok := algorithm.Min(tc.input); min != tc.expectedMin || ok != tc.expectedOK {
+				t.Fatalf("Min: expected (%v, %v) but got (%v, %v)", tc.expectedMin, tc.expectedOK, min, ok)
+			}
+			if max, ok := algorithm.Max(tc.input); max != tc.expectedMax || ok != tc.expectedOK {
+				t.Fatalf("Max: expected (%v, %v) but got (%v, %v)", tc.expectedMax, tc.expectedOK, max, ok)
+			}
+			if min, max, ok := algorithm.MinMax(tc.input); min != tc.expectedMin || max != tc.expectedMax || ok != tc.expectedOK {
+				t.Fatalf("MinMax: expected (%v, %v, %v) but got (%v, %v, %v)", tc.expectedMin, tc.expectedMax, tc.expectedOK, min, max, ok)
+			}
+
+			greater := func(x, y int) bool { return x > y }
+			if max, ok := algorithm.MinFunc(tc.input, greater); max != tc.expectedMax || ok != tc.expectedOK {
+				t.Fatalf("MinFunc: expected (%v, %v) but got (%v, %v)", tc.expectedMax, tc.expectedOK, max, ok)
+			}
+			if min, ok := algorithm.MaxFunc(tc.input, greater); min != tc.expectedMin || ok != tc.expectedOK {
+				t.Fatalf("MaxFunc: expected (%v, %v) but got (%v, %v)", tc.expectedMin, tc.expectedOK, min, ok)
+			}
+		})
+	}
+}
+
+func TestMinMaxFunc_Comparisons(t *testing.T) {
+	values := []int{5, 2, 8, 1, 9, 3, 7, 4, 6, 0}
+	comparisons := 0
+	algorithm.MinMaxFunc(values, func(x, y int) bool {
+		comparisons++
+		return x < y
+	})
+	if comparisons > 3*len(values)/2 {
+		t.Fatalf("expected at most %v comparisons but got %v", 3*len(values)/2, comparisons)
+	}
+}
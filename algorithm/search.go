@@ -20,3 +20,22 @@ func Search[T cmp.Ordered](values []T, target T) int {
 		return !cmp.Less(values[i], target)
 	})
 }
+
+// SearchFound searches for target in a sorted array of values using less
+// and return the smallest index i which satisfies !less(values[i], target).
+// It also reports whether values[i] is equal to target.
+func SearchFound[T any](values []T, target T, less LessFunc[T]) (index int, found bool) {
+	index = SearchFunc(values, target, less)
+	return index, index < len(values) && !less(target, values[index])
+}
+
+// BinaryInsert inserts item into a sorted array of values using less, so the array
+// stays sorted, and returns the grown array. The item is inserted before equal elements.
+func BinaryInsert[T any](values []T, item T, less LessFunc[T]) []T {
+	index := SearchFunc(values, item, less)
+	var empty T
+	values = append(values, empty)
+	copy(values[index+1:], values[index:])
+	values[index] = item
+	return values
+}
@@ -45,3 +45,87 @@ func TestSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchFound(t *testing.T) {
+	testCases := map[string]struct {
+		input         []int
+		target        int
+		expectedIndex int
+		expectedFound bool
+	}{
+		"should be found if the target is in the array": {
+			input:         []int{1, 2, 2, 3},
+			target:        2,
+			expectedIndex: 1,
+			expectedFound: true,
+		},
+		"should not be found if the target is missing in the middle": {
+			input:         []int{1, 2, 4},
+			target:        3,
+			expectedIndex: 2,
+		},
+		"should not be found if the target is bigger than all elements": {
+			input:         []int{1, 2, 4},
+			target:        5,
+			expectedIndex: 3,
+		},
+		"should not be found if the array is empty": {
+			input:  []int{},
+			target: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			index, found := algorithm.SearchFound(tc.input, tc.target, func(x, y int) bool { return x < y })
+			if index != tc.expectedIndex || found != tc.expectedFound {
+				t.Fatalf("expected (%v, %v) but got (%v, %v)", tc.expectedIndex, tc.expectedFound, index, found)
+			}
+		})
+	}
+}
+
+func TestBinaryInsert(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		item     int
+		expected []int
+	}{
+		"should insert at the front": {
+			input:    []int{2, 3, 4},
+			item:     1,
+			expected: []int{1, 2, 3, 4},
+		},
+		"should insert in the middle": {
+			input:    []int{1, 2, 4},
+			item:     3,
+			expected: []int{1, 2, 3, 4},
+		},
+		"should insert at the end": {
+			input:    []int{1, 2, 3},
+			item:     4,
+			expected: []int{1, 2, 3, 4},
+		},
+		"should insert a duplicate": {
+			input:    []int{1, 2, 3},
+			item:     2,
+			expected: []int{1, 2, 2, 3},
+		},
+		"should insert into an empty array": {
+			input:    nil,
+			item:     1,
+			expected: []int{1},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := algorithm.BinaryInsert(tc.input, tc.item, func(x, y int) bool { return x < y })
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+		})
+	}
+}
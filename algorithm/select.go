@@ -0,0 +1,40 @@
+package algorithm
+
+import "math/rand"
+
+// Select returns the k-th smallest element (0-based) of an array using less
+// in expected O(n) by quickselect. The array is partially reordered in place.
+// It panics if k is out of range.
+func Select[T any](values []T, k int, less LessFunc[T]) T {
+	if k < 0 || k >= len(values) {
+		panic("algorithm: k is out of range")
+	}
+	lo, hi := 0, len(values)
+	for {
+		// three-way partition values[lo:hi] around a random pivot, so values
+		// in [lt, gt) are equal to the pivot.
+		pivot := values[lo+rand.Intn(hi-lo)]
+		lt, i, gt := lo, lo, hi
+		for i < gt {
+			switch {
+			case less(values[i], pivot):
+				values[lt], values[i] = values[i], values[lt]
+				lt++
+				i++
+			case less(pivot, values[i]):
+				gt--
+				values[gt], values[i] = values[i], values[gt]
+			default:
+				i++
+			}
+		}
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return values[k]
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package algorithm_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+)
+
+func TestSelect(t *testing.T) {
+	less := func(x, y int) bool { return x < y }
+	testCases := map[string][]int{
+		"single element":  {1},
+		"sorted":          {1, 2, 3, 4, 5},
+		"reverse-sorted":  {5, 4, 3, 2, 1},
+		"with duplicates": {3, 1, 3, 3, 2, 1, 3},
+		"all equal":       {2, 2, 2, 2},
+		"random":          rand.Perm(100),
+	}
+
+	for name, input := range testCases {
+		input := input
+		t.Run(name, func(t *testing.T) {
+			sorted := append([]int{}, input...)
+			algorithm.SortFunc(sorted, less)
+			for k := range input {
+				values := append([]int{}, input...)
+				if got := algorithm.Select(values, k, less); got != sorted[k] {
+					t.Fatalf("expected %v at %v but got %v", sorted[k], k, got)
+				}
+			}
+		})
+	}
+
+	t.Run("should panic if k is out of range", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		algorithm.Select([]int{1, 2}, 2, less)
+	})
+}
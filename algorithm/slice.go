@@ -0,0 +1,148 @@
+package algorithm
+
+// Reverse reverses an array of values in place.
+func Reverse[T any](values []T) {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+}
+
+// UniqueFunc removes consecutive duplicates from an array of values in place using eq
+// and returns the truncated array. The array is usually sorted beforehand.
+func UniqueFunc[T any](values []T, eq func(a, b T) bool) []T {
+	if len(values) == 0 {
+		return values
+	}
+	n := 1
+	for i := 1; i < len(values); i++ {
+		if !eq(values[n-1], values[i]) {
+			values[n] = values[i]
+			n++
+		}
+	}
+	var empty T
+	for i := n; i < len(values); i++ {
+		values[i] = empty // avoid memory leak
+	}
+	return values[:n]
+}
+
+// Unique removes consecutive duplicates from an array of values in place
+// and returns the truncated array. The array is usually sorted beforehand.
+func Unique[T comparable](values []T) []T {
+	return UniqueFunc(values, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// IndexFunc returns the index of the first element which satisfies pred
+// or -1 if there is no such element.
+func IndexFunc[T any](values []T, pred func(T) bool) int {
+	for i, v := range values {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Index returns the index of the first occurrence of target in an array of values
+// or -1 if target isn't present. Unlike Search, the array doesn't need to be sorted.
+func Index[T comparable](values []T, target T) int {
+	for i, v := range values {
+		if v == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains returns whether target is present in an array of values.
+func Contains[T comparable](values []T, target T) bool {
+	return Index(values, target) >= 0
+}
+
+// Partition rearranges an array of values in place so that all elements satisfying pred
+// come before those which don't, and returns the index of the first element which doesn't.
+// The relative order of elements isn't preserved.
+func Partition[T any](values []T, pred func(T) bool) int {
+	i, j := 0, len(values)
+	for {
+		for i < j && pred(values[i]) {
+			i++
+		}
+		for i < j && !pred(values[j-1]) {
+			j--
+		}
+		if i >= j {
+			return i
+		}
+		values[i], values[j-1] = values[j-1], values[i]
+		i++
+		j--
+	}
+}
+
+// StablePartition is like Partition but preserves the relative order of elements
+// in each group. It allocates a buffer for elements which don't satisfy pred.
+func StablePartition[T any](values []T, pred func(T) bool) int {
+	var rest []T
+	n := 0
+	for _, v := range values {
+		if pred(v) {
+			values[n] = v
+			n++
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	copy(values[n:], rest)
+	return n
+}
+
+// Rotate rotates an array of values to the left by k positions in place.
+// A negative k rotates the array to the right.
+func Rotate[T any](values []T, k int) {
+	n := len(values)
+	if n == 0 {
+		return
+	}
+	k %= n
+	if k < 0 {
+		k += n
+	}
+	Reverse(values[:k])
+	Reverse(values[k:])
+	Reverse(values)
+}
+
+// Chunk splits an array of values into consecutive sub-slices of at most size elements.
+// The last chunk may be shorter. If size isn't positive, values is returned as one chunk.
+// The chunks share the underlying array with values.
+func Chunk[T any](values []T, size int) [][]T {
+	if len(values) == 0 {
+		return nil
+	}
+	if size <= 0 {
+		return [][]T{values}
+	}
+	chunks := make([][]T, 0, (len(values)+size-1)/size)
+	for size < len(values) {
+		chunks = append(chunks, values[:size:size])
+		values = values[size:]
+	}
+	return append(chunks, values)
+}
+
+// Flatten concatenates nested arrays of values into a new array.
+func Flatten[T any](values [][]T) []T {
+	n := 0
+	for _, v := range values {
+		n += len(v)
+	}
+	flattened := make([]T, 0, n)
+	for _, v := range values {
+		flattened = append(flattened, v...)
+	}
+	return flattened
+}
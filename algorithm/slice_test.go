@@ -0,0 +1,317 @@
+package algorithm_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestReverse(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		expected []int
+	}{
+		"should reverse an even-length array": {
+			input:    []int{1, 2, 3, 4},
+			expected: []int{4, 3, 2, 1},
+		},
+		"should reverse an odd-length array": {
+			input:    []int{1, 2, 3},
+			expected: []int{3, 2, 1},
+		},
+		"should be fine if the array is empty": {
+			input:    []int{},
+			expected: []int{},
+		},
+		"should be fine if the array has a single element": {
+			input:    []int{1},
+			expected: []int{1},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			algorithm.Reverse(tc.input)
+			if diff := gocmp.Diff(tc.expected, tc.input); diff != "" {
+				t.Fatalf("the array isn't reversed: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		expected []int
+	}{
+		"should keep one element if all elements are equal": {
+			input:    []int{2, 2, 2},
+			expected: []int{2},
+		},
+		"should be fine if there is no duplicate": {
+			input:    []int{1, 2, 3},
+			expected: []int{1, 2, 3},
+		},
+		"should remove interleaved duplicates": {
+			input:    []int{1, 1, 2, 3, 3, 3, 4, 5, 5},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		"should be fine if the array is empty": {
+			input:    []int{},
+			expected: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := algorithm.Unique(append([]int{}, tc.input...))
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+			got = algorithm.UniqueFunc(append([]int{}, tc.input...), func(a, b int) bool { return a == b })
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected result of UniqueFunc: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		target   int
+		expected int
+	}{
+		"should return the index if the target is present": {
+			input:    []int{3, 1, 2},
+			target:   1,
+			expected: 1,
+		},
+		"should return the first match": {
+			input:    []int{3, 1, 2, 1},
+			target:   1,
+			expected: 1,
+		},
+		"should return -1 if the target is absent": {
+			input:    []int{3, 1, 2},
+			target:   4,
+			expected: -1,
+		},
+		"should return -1 if the array is empty": {
+			input:    []int{},
+			target:   1,
+			expected: -1,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if index := algorithm.Index(tc.input, tc.target); index != tc.expected {
+				t.Fatalf("Index: expected %v but got %v", tc.expected, index)
+			}
+			if index := algorithm.IndexFunc(tc.input, func(v int) bool { return v == tc.target }); index != tc.expected {
+				t.Fatalf("IndexFunc: expected %v but got %v", tc.expected, index)
+			}
+			if found := algorithm.Contains(tc.input, tc.target); found != (tc.expected >= 0) {
+				t.Fatalf("Contains: expected %v but got %v", tc.expected >= 0, found)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	testCases := map[string]struct {
+		input          []int
+		expectedIndex  int
+		expectedStable []int
+	}{
+		"should be fine if all elements satisfy the predicate": {
+			input:          []int{2, 4, 6},
+			expectedIndex:  3,
+			expectedStable: []int{2, 4, 6},
+		},
+		"should be fine if no element satisfies the predicate": {
+			input:          []int{1, 3, 5},
+			expectedIndex:  0,
+			expectedStable: []int{1, 3, 5},
+		},
+		"should partition mixed elements": {
+			input:          []int{1, 2, 3, 4, 5, 6, 8},
+			expectedIndex:  4,
+			expectedStable: []int{2, 4, 6, 8, 1, 3, 5},
+		},
+		"should be fine if the array is empty": {
+			input:          []int{},
+			expectedIndex:  0,
+			expectedStable: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			values := append([]int{}, tc.input...)
+			index := algorithm.Partition(values, isEven)
+			if index != tc.expectedIndex {
+				t.Fatalf("expected index %v but got %v", tc.expectedIndex, index)
+			}
+			for i, v := range values {
+				if isEven(v) != (i < index) {
+					t.Fatalf("the array isn't partitioned: %v", values)
+				}
+			}
+			sorted, expected := append([]int{}, values...), append([]int{}, tc.input...)
+			algorithm.Sort(sorted)
+			algorithm.Sort(expected)
+			if diff := gocmp.Diff(expected, sorted); diff != "" {
+				t.Fatalf("elements are changed: %s", diff)
+			}
+
+			values = append([]int{}, tc.input...)
+			if index := algorithm.StablePartition(values, isEven); index != tc.expectedIndex {
+				t.Fatalf("expected stable index %v but got %v", tc.expectedIndex, index)
+			}
+			if diff := gocmp.Diff(tc.expectedStable, values); diff != "" {
+				t.Fatalf("the array isn't partitioned stably: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		k        int
+		expected []int
+	}{
+		"should rotate to the left": {
+			input:    []int{1, 2, 3, 4, 5},
+			k:        2,
+			expected: []int{3, 4, 5, 1, 2},
+		},
+		"should rotate to the right if k is negative": {
+			input:    []int{1, 2, 3, 4, 5},
+			k:        -1,
+			expected: []int{5, 1, 2, 3, 4},
+		},
+		"should be fine if k is zero": {
+			input:    []int{1, 2, 3},
+			k:        0,
+			expected: []int{1, 2, 3},
+		},
+		"should be fine if k equals the length": {
+			input:    []int{1, 2, 3},
+			k:        3,
+			expected: []int{1, 2, 3},
+		},
+		"should rotate by k modulo the length": {
+			input:    []int{1, 2, 3, 4},
+			k:        9,
+			expected: []int{2, 3, 4, 1},
+		},
+		"should rotate to the right by k modulo the length": {
+			input:    []int{1, 2, 3, 4},
+			k:        -6,
+			expected: []int{3, 4, 1, 2},
+		},
+		"should be fine if the array is empty": {
+			input:    []int{},
+			k:        2,
+			expected: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			algorithm.Rotate(tc.input, tc.k)
+			if diff := gocmp.Diff(tc.expected, tc.input); diff != "" {
+				t.Fatalf("the array isn't rotated: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		"should split an exact multiple": {
+			input:    []int{1, 2, 3, 4},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}},
+		},
+		"should have a short last chunk": {
+			input:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		"should return one chunk if size is larger than the array": {
+			input:    []int{1, 2},
+			size:     5,
+			expected: [][]int{{1, 2}},
+		},
+		"should return one chunk if size isn't positive": {
+			input:    []int{1, 2, 3},
+			size:     0,
+			expected: [][]int{{1, 2, 3}},
+		},
+		"should return no chunk if the array is empty": {
+			input:    []int{},
+			size:     2,
+			expected: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := algorithm.Chunk(tc.input, tc.size)
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected chunks: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	testCases := map[string]struct {
+		input    [][]int
+		expected []int
+	}{
+		"should be empty if the outer array is empty": {
+			input:    [][]int{},
+			expected: []int{},
+		},
+		"should skip empty inner arrays": {
+			input:    [][]int{{}, {1}, nil, {}},
+			expected: []int{1},
+		},
+		"should concatenate arrays of mixed lengths": {
+			input:    [][]int{{1, 2, 3}, {4}, {5, 6}},
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := algorithm.Flatten(tc.input)
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+		})
+	}
+
+	chunks := algorithm.Chunk([]int{1, 2, 3, 4, 5}, 2)
+	if diff := gocmp.Diff([]int{1, 2, 3, 4, 5}, algorithm.Flatten(chunks)); diff != "" {
+		t.Fatalf("unexpected result of flattening chunks: %s", diff)
+	}
+}
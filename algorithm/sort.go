@@ -37,3 +37,61 @@ func (sc *sortableContainer[T]) Swap(i, j int) {
 func Sort[T cmp.Ordered](values []T) {
 	SortFunc(values, cmp.Less[T])
 }
+
+// SortDescendingFunc sorts an array in non-increasing order using less.
+func SortDescendingFunc[T any](values []T, less LessFunc[T]) {
+	SortFunc(values, func(x, y T) bool {
+		return less(y, x)
+	})
+}
+
+// SortDescending sorts an array of values from ordered types in non-increasing order.
+func SortDescending[T cmp.Ordered](values []T) {
+	SortDescendingFunc(values, cmp.Less[T])
+}
+
+// SortStableFunc sorts an array using less while keeping the original order of equal elements.
+func SortStableFunc[T any](values []T, less LessFunc[T]) {
+	sort.Stable(&sortableContainer[T]{
+		values: values,
+		less:   less,
+	})
+}
+
+// SortStable sorts an array of values from ordered types while keeping the original order of equal elements.
+func SortStable[T cmp.Ordered](values []T) {
+	SortStableFunc(values, cmp.Less[T])
+}
+
+// IsSortedFunc returns whether an array is sorted in non-decreasing order according to less.
+func IsSortedFunc[T any](values []T, less LessFunc[T]) bool {
+	for i := 1; i < len(values); i++ {
+		if less(values[i], values[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSorted returns whether an array of values from ordered types is sorted in non-decreasing order.
+func IsSorted[T cmp.Ordered](values []T) bool {
+	return IsSortedFunc(values, cmp.Less[T])
+}
+
+// MergeSorted merges two sorted arrays into a new sorted array using less in O(n+m).
+// Elements of a come before equal elements of b.
+func MergeSorted[T any](a, b []T, less LessFunc[T]) []T {
+	merged := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			merged = append(merged, b[j])
+			j++
+		} else {
+			merged = append(merged, a[i])
+			i++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
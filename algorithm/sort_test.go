@@ -32,3 +32,160 @@ func TestSort(t *testing.T) {
 		})
 	}
 }
+
+func TestSortStableFunc(t *testing.T) {
+	type record struct {
+		key  int
+		name string
+	}
+	input := []record{
+		{key: 2, name: "a"},
+		{key: 1, name: "b"},
+		{key: 2, name: "c"},
+		{key: 1, name: "d"},
+		{key: 0, name: "e"},
+		{key: 2, name: "f"},
+	}
+	expected := []record{
+		{key: 0, name: "e"},
+		{key: 1, name: "b"},
+		{key: 1, name: "d"},
+		{key: 2, name: "a"},
+		{key: 2, name: "c"},
+		{key: 2, name: "f"},
+	}
+
+	algorithm.SortStableFunc(input, func(x, y record) bool {
+		return x.key < y.key
+	})
+	if diff := gocmp.Diff(expected, input, gocmp.AllowUnexported(record{})); diff != "" {
+		t.Fatalf("the array isn't sorted stably: %s", diff)
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	input := []int{3, 1, 2, 1}
+	algorithm.SortStable(input)
+	if diff := gocmp.Diff([]int{1, 1, 2, 3}, input); diff != "" {
+		t.Fatalf("the array isn't sorted: %s", diff)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		expected bool
+	}{
+		"should be true if the array is sorted": {
+			input:    []int{1, 2, 2, 3},
+			expected: true,
+		},
+		"should be false if the array is reverse-sorted": {
+			input:    []int{3, 2, 1},
+			expected: false,
+		},
+		"should be false if only the last element is out of order": {
+			input:    []int{1, 2, 3, 0},
+			expected: false,
+		},
+		"should be true if the array has a single element": {
+			input:    []int{1},
+			expected: true,
+		},
+		"should be true if the array is empty": {
+			input:    []int{},
+			expected: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if sorted := algorithm.IsSorted(tc.input); sorted != tc.expected {
+				t.Fatalf("expected %v but got %v", tc.expected, sorted)
+			}
+			greater := func(x, y int) bool { return x > y }
+			reversed := append([]int{}, tc.input...)
+			algorithm.Reverse(reversed)
+			if sorted := algorithm.IsSortedFunc(reversed, greater); sorted != tc.expected {
+				t.Fatalf("expected %v but got %v for the reversed array", tc.expected, sorted)
+			}
+		})
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     []int
+		expected []int
+	}{
+		"should merge interleaved values": {
+			a:        []int{1, 3, 5},
+			b:        []int{2, 4, 6},
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+		"should merge arrays with unequal lengths": {
+			a:        []int{1, 10},
+			b:        []int{2, 3, 4, 11, 12},
+			expected: []int{1, 2, 3, 4, 10, 11, 12},
+		},
+		"should merge duplicates": {
+			a:        []int{1, 2, 2},
+			b:        []int{2, 3},
+			expected: []int{1, 2, 2, 2, 3},
+		},
+		"should be fine if an array is empty": {
+			a:        []int{},
+			b:        []int{1, 2},
+			expected: []int{1, 2},
+		},
+		"should be fine if both arrays are empty": {
+			a:        []int{},
+			b:        []int{},
+			expected: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			merged := algorithm.MergeSorted(tc.a, tc.b, func(x, y int) bool { return x < y })
+			if diff := gocmp.Diff(tc.expected, merged); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSortDescending(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		expected []int
+	}{
+		"should sort if the array is ascending": {
+			input:    []int{1, 2, 3},
+			expected: []int{3, 2, 1},
+		},
+		"should sort if the array has duplicates": {
+			input:    []int{2, 3, 1, 3, 2},
+			expected: []int{3, 3, 2, 2, 1},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			values := append([]int{}, tc.input...)
+			algorithm.SortDescending(values)
+			if diff := gocmp.Diff(tc.expected, values); diff != "" {
+				t.Fatalf("the array isn't sorted: %s", diff)
+			}
+
+			values = append([]int{}, tc.input...)
+			algorithm.SortDescendingFunc(values, func(x, y int) bool { return x < y })
+			if diff := gocmp.Diff(tc.expected, values); diff != "" {
+				t.Fatalf("the array isn't sorted by SortDescendingFunc: %s", diff)
+			}
+		})
+	}
+}
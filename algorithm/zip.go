@@ -0,0 +1,31 @@
+package algorithm
+
+// Pair is a pair of values.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs elements of a and b at the same index.
+// The result is truncated to the length of the shorter array.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	pairs := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = Pair[A, B]{
+			First:  a[i],
+			Second: b[i],
+		}
+	}
+	return pairs
+}
+
+// Unzip splits an array of pairs into two arrays of their first and second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+	return a, b
+}
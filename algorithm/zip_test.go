@@ -0,0 +1,63 @@
+package algorithm_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestZip(t *testing.T) {
+	testCases := map[string]struct {
+		a        []int
+		b        []string
+		expected []algorithm.Pair[int, string]
+	}{
+		"should pair arrays with equal lengths": {
+			a: []int{1, 2},
+			b: []string{"a", "b"},
+			expected: []algorithm.Pair[int, string]{
+				{First: 1, Second: "a"},
+				{First: 2, Second: "b"},
+			},
+		},
+		"should truncate to the shorter array": {
+			a: []int{1, 2, 3},
+			b: []string{"a"},
+			expected: []algorithm.Pair[int, string]{
+				{First: 1, Second: "a"},
+			},
+		},
+		"should be empty if an array is empty": {
+			a:        []int{},
+			b:        []string{"a"},
+			expected: []algorithm.Pair[int, string]{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := algorithm.Zip(tc.a, tc.b)
+			if diff := gocmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected pairs: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := []int{3, 1, 2}, []string{"c", "a", "b"}
+	pairs := algorithm.Zip(a, b)
+	algorithm.SortFunc(pairs, func(x, y algorithm.Pair[int, string]) bool {
+		return x.First < y.First
+	})
+
+	gotA, gotB := algorithm.Unzip(pairs)
+	if diff := gocmp.Diff([]int{1, 2, 3}, gotA); diff != "" {
+		t.Fatalf("unexpected first values: %s", diff)
+	}
+	if diff := gocmp.Diff([]string{"a", "b", "c"}, gotB); diff != "" {
+		t.Fatalf("unexpected second values: %s", diff)
+	}
+}
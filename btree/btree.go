@@ -49,6 +49,15 @@ func NewBTree[T cmp.Ordered]() *BTree[T] {
 	return NewBTreeOptions(cmp.Less[T], Options{Degree: 2})
 }
 
+// NewFromSorted returns a new BTree of items which are sorted in ascending order.
+// The tree is built bottom-up in O(n). If items aren't sorted or have duplicates,
+// it falls back to inserting items one by one.
+func NewFromSorted[T cmp.Ordered](items []T) *BTree[T] {
+	tr := NewBTree[T]()
+	tr.loadSorted(items)
+	return tr
+}
+
 func NewBTreeFunc[T any](less func(a, b T) bool) *BTree[T] {
 	return NewBTreeOptions(less, Options{})
 }
@@ -222,6 +231,27 @@ func (tr *BTree[T]) Upsert(item T) (T, bool) {
 	return tr.SetHint(item, nil)
 }
 
+// GetOrInsert returns the existing item equal to item if it's present.
+// Otherwise, it inserts item and returns it.
+// The loaded result is true if the item was found, false if inserted.
+func (tr *BTree[T]) GetOrInsert(item T) (actual T, loaded bool) {
+	var hint PathHint
+	if actual, loaded = tr.getHint(item, &hint, false); loaded {
+		return actual, true
+	}
+	tr.setHint(item, &hint)
+	return item, false
+}
+
+// SetMany inserts or replaces all items.
+// A path hint is shared between items, so it's faster for clustered items.
+func (tr *BTree[T]) SetMany(items []T) {
+	var hint PathHint
+	for _, item := range items {
+		tr.setHint(item, &hint)
+	}
+}
+
 func (tr *BTree[T]) nodeSplit(n *node[T]) (right *node[T], median T) {
 	i := tr.max / 2
 	median = n.items[i]
@@ -372,6 +402,62 @@ func (tr *BTree[T]) nodeScan(cn **node[T], iter func(item T) bool, mut bool,
 	return tr.nodeScan(&(*n.children)[len(*n.children)-1], iter, mut)
 }
 
+// ScanPage skips the first offset items then calls iter for at most limit
+// items in ascending order. Skipped subtrees aren't visited.
+// Return false to stop iterating
+func (tr *BTree[T]) ScanPage(offset, limit int, iter func(item T) bool) {
+	if offset < 0 {
+		offset = 0
+	}
+	if tr.root == nil || offset >= tr.count || limit <= 0 {
+		return
+	}
+	tr.nodeScanPage(tr.root, offset, &limit, iter)
+}
+
+func (tr *BTree[T]) nodeScanPage(n *node[T], offset int, limit *int,
+	iter func(item T) bool,
+) bool {
+	if n.leaf() {
+		for i := offset; i < len(n.items); i++ {
+			if *limit == 0 {
+				return false
+			}
+			*limit--
+			if !iter(n.items[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	for i := 0; i <= len(n.items); i++ {
+		child := (*n.children)[i]
+		if offset < child.count {
+			if !tr.nodeScanPage(child, offset, limit, iter) {
+				return false
+			}
+			offset = 0
+		} else {
+			offset -= child.count
+		}
+		if i == len(n.items) {
+			break
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if *limit == 0 {
+			return false
+		}
+		*limit--
+		if !iter(n.items[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // Get a value for key
 func (tr *BTree[T]) Get(key T) (T, bool) {
 	return tr.getHint(key, nil, false)
@@ -410,6 +496,79 @@ func (tr *BTree[T]) getHint(key T, hint *PathHint, mut bool) (T, bool) {
 	}
 }
 
+// GetGE returns the smallest item greater than or equal to key.
+// Returns false if there is no such item.
+func (tr *BTree[T]) GetGE(key T) (T, bool) {
+	return tr.getNeighbor(key, true, true)
+}
+
+// GetGT returns the smallest item greater than key.
+// Returns false if there is no such item.
+func (tr *BTree[T]) GetGT(key T) (T, bool) {
+	return tr.getNeighbor(key, true, false)
+}
+
+// GetLE returns the largest item less than or equal to key.
+// Returns false if there is no such item.
+func (tr *BTree[T]) GetLE(key T) (T, bool) {
+	return tr.getNeighbor(key, false, true)
+}
+
+// GetLT returns the largest item less than key.
+// Returns false if there is no such item.
+func (tr *BTree[T]) GetLT(key T) (T, bool) {
+	return tr.getNeighbor(key, false, false)
+}
+
+func (tr *BTree[T]) getNeighbor(key T, greater, orEqual bool) (T, bool) {
+	if tr.root == nil {
+		return tr.empty, false
+	}
+	item, ok := tr.empty, false
+	n := tr.root
+	for {
+		i, found := tr.bsearch(n, key)
+		if found && orEqual {
+			return n.items[i], true
+		}
+		if greater {
+			// items[i] is the smallest item greater than key in this node.
+			if found {
+				i++
+			}
+			if i < len(n.items) {
+				item, ok = n.items[i], true
+			}
+		} else if i > 0 {
+			// items[i-1] is the largest item less than key in this node.
+			item, ok = n.items[i-1], true
+		}
+		if n.leaf() {
+			return item, ok
+		}
+		n = (*n.children)[i]
+	}
+}
+
+// Contains returns whether the tree has an item equal to key.
+// Unlike Get, it doesn't copy the found item.
+func (tr *BTree[T]) Contains(key T) bool {
+	if tr.root == nil {
+		return false
+	}
+	n := tr.root
+	for {
+		i, found := tr.bsearch(n, key)
+		if found {
+			return true
+		}
+		if n.leaf() {
+			return false
+		}
+		n = (*n.children)[i]
+	}
+}
+
 // Len returns the number of items in the tree
 func (tr *BTree[T]) Len() int {
 	return tr.count
@@ -495,6 +654,31 @@ func (tr *BTree[T]) delete(cn **node[T], max bool, key T,
 	return prev, true
 }
 
+// DeleteMany deletes items equal to keys and returns the number of deleted items.
+// A path hint is shared between keys, so it's faster for clustered keys.
+func (tr *BTree[T]) DeleteMany(keys []T) int {
+	var hint PathHint
+	var deleted int
+	for _, key := range keys {
+		if _, ok := tr.deleteHint(key, &hint); ok {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// DeleteRange deletes all items within the range [low, high) and returns
+// the number of deleted items.
+func (tr *BTree[T]) DeleteRange(low, high T) int {
+	var items []T
+	tr.AscendRange(low, high, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	tr.DeleteMany(items)
+	return len(items)
+}
+
 // nodeRebalance rebalances the child nodes following a delete operation.
 // Provide the index of the child node with the number of items that fell
 // below minItems.
@@ -628,6 +812,17 @@ func (tr *BTree[T]) nodeAscend(cn **node[T], pivot T, hint *PathHint,
 	return true
 }
 
+// AscendRange ascends the tree within the range [greaterOrEqual, lessThan)
+// Return false to stop iterating
+func (tr *BTree[T]) AscendRange(greaterOrEqual, lessThan T, iter func(item T) bool) {
+	tr.ascend(greaterOrEqual, func(item T) bool {
+		if !tr.less(item, lessThan) {
+			return false
+		}
+		return iter(item)
+	}, false)
+}
+
 func (tr *BTree[T]) ReverseScan(iter func(item T) bool) {
 	tr.reverse(iter, false)
 }
@@ -713,6 +908,18 @@ func (tr *BTree[T]) nodeDescend(cn **node[T], pivot T, hint *PathHint,
 	return true
 }
 
+// DescendRange descends the tree within the range (greaterThan, lessOrEqual],
+// i.e. items <= lessOrEqual and > greaterThan, in descending order.
+// Return false to stop iterating
+func (tr *BTree[T]) DescendRange(lessOrEqual, greaterThan T, iter func(item T) bool) {
+	tr.descend(lessOrEqual, func(item T) bool {
+		if !tr.less(greaterThan, item) {
+			return false
+		}
+		return iter(item)
+	}, false)
+}
+
 // Load is for bulk loading pre-sorted items
 func (tr *BTree[T]) Load(item T) (T, bool) {
 	if tr.root == nil {
@@ -745,6 +952,83 @@ func (tr *BTree[T]) Load(item T) (T, bool) {
 	return tr.setHint(item, nil)
 }
 
+// LoadMany bulk loads pre-sorted items and returns the number of new items.
+// An item equal to the previous one is skipped, so the first one is kept,
+// while an item equal to an existing item of the tree replaces it like Load.
+func (tr *BTree[T]) LoadMany(items []T) int {
+	n := 0
+	for i, item := range items {
+		if i > 0 && !tr.less(items[i-1], item) && !tr.less(item, items[i-1]) {
+			continue
+		}
+		if _, replaced := tr.Load(item); !replaced {
+			n++
+		}
+	}
+	return n
+}
+
+// loadSorted loads items into an empty tree.
+func (tr *BTree[T]) loadSorted(items []T) {
+	for i := 1; i < len(items); i++ {
+		if !tr.less(items[i-1], items[i]) {
+			for _, item := range items {
+				tr.Upsert(item)
+			}
+			return
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	tr.init(0)
+	// caps[h] is the maximum number of items of a subtree with height h.
+	caps := []int{0, tr.max}
+	for caps[len(caps)-1] < len(items) {
+		caps = append(caps, caps[len(caps)-1]*(tr.max+1)+tr.max)
+	}
+	tr.root = tr.buildNode(items, len(caps)-1, caps, true)
+	tr.count = len(items)
+}
+
+// buildNode builds a subtree with the given height from sorted items.
+// The items are spread evenly across children so every node satisfies
+// the min and max constraints.
+func (tr *BTree[T]) buildNode(items []T, height int, caps []int, root bool) *node[T] {
+	n := tr.newNode(height == 1)
+	if height == 1 {
+		n.items = append([]T{}, items...)
+		n.count = len(n.items)
+		return n
+	}
+
+	numChildren := (len(items) + 1 + caps[height-1]) / (caps[height-1] + 1)
+	if !root && numChildren < tr.min+1 {
+		numChildren = tr.min + 1
+	}
+	size := (len(items) + 1 - numChildren) / numChildren
+	extra := (len(items) + 1 - numChildren) % numChildren
+	n.items = make([]T, 0, numChildren-1)
+	*n.children = make([]*node[T], 0, tr.max+1)
+	pos := 0
+	for i := 0; i < numChildren; i++ {
+		childSize := size
+		if i < extra {
+			childSize++
+		}
+		child := tr.buildNode(items[pos:pos+childSize], height-1, caps, false)
+		*n.children = append(*n.children, child)
+		pos += childSize
+		if i < numChildren-1 {
+			n.items = append(n.items, items[pos])
+			pos++
+		}
+	}
+	n.updateCount()
+	return n
+}
+
 // Min returns the minimum item in tree.
 // Returns nil if the treex has no items.
 func (tr *BTree[T]) Min() (T, bool) {
@@ -791,6 +1075,29 @@ func (tr *BTree[T]) maxMut(mut bool) (T, bool) {
 	}
 }
 
+// MinMax returns the minimum and maximum items in tree.
+// Returns false if the tree has no items.
+func (tr *BTree[T]) MinMax() (min, max T, ok bool) {
+	if tr.root == nil {
+		return tr.empty, tr.empty, false
+	}
+	min, _ = tr.minMut(false)
+	max, _ = tr.maxMut(false)
+	return min, max, true
+}
+
+// PopMin removes the minimum item in tree and returns it.
+// It's the same as DeleteMin.
+func (tr *BTree[T]) PopMin() (T, bool) {
+	return tr.DeleteMin()
+}
+
+// PopMax removes the maximum item in tree and returns it.
+// It's the same as DeleteMax.
+func (tr *BTree[T]) PopMax() (T, bool) {
+	return tr.DeleteMax()
+}
+
 // DeleteMin removes the minimum item in tree and returns it.
 // Returns nil if the tree has no items.
 func (tr *BTree[T]) DeleteMin() (T, bool) {
@@ -872,6 +1179,22 @@ func (tr *BTree[T]) GetAt(index int) (T, bool) {
 	return tr.getAt(index, false)
 }
 
+// GetRange returns the items at indexes within [startIndex, endIndex) in order.
+// Out of bounds indexes are clamped to the tree.
+func (tr *BTree[T]) GetRange(startIndex, endIndex int) []T {
+	startIndex = max(startIndex, 0)
+	endIndex = min(endIndex, tr.Len())
+	if startIndex >= endIndex {
+		return nil
+	}
+	items := make([]T, 0, endIndex-startIndex)
+	tr.ScanPage(startIndex, endIndex-startIndex, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
 func (tr *BTree[T]) GetAtMut(index int) (T, bool) {
 	return tr.getAt(index, true)
 }
@@ -898,6 +1221,38 @@ func (tr *BTree[T]) getAt(index int, mut bool) (T, bool) {
 	}
 }
 
+// Rank returns the number of items less than key,
+// which is the index of key if it's in the tree.
+func (tr *BTree[T]) Rank(key T) int {
+	if tr.root == nil {
+		return 0
+	}
+	var rank int
+	n := tr.root
+	for {
+		i, found := tr.bsearch(n, key)
+		rank += i
+		if n.leaf() {
+			return rank
+		}
+		for j := 0; j < i; j++ {
+			rank += (*n.children)[j].count
+		}
+		if found {
+			return rank + (*n.children)[i].count
+		}
+		n = (*n.children)[i]
+	}
+}
+
+// CountRange returns the number of items within the range [low, high).
+func (tr *BTree[T]) CountRange(low, high T) int {
+	if !tr.less(low, high) {
+		return 0
+	}
+	return tr.Rank(high) - tr.Rank(low)
+}
+
 // DeleteAt deletes the item at index.
 // Return nil if the tree is empty or the index is out of bounds.
 func (tr *BTree[T]) DeleteAt(index int) (T, bool) {
@@ -974,6 +1329,65 @@ func (tr *BTree[T]) Height() int {
 	return height
 }
 
+// TreeStats reports metrics of a tree.
+type TreeStats struct {
+	// Nodes is the number of nodes.
+	Nodes int
+	// Height is the height of the tree.
+	Height int
+	// Items is the number of items.
+	Items int
+	// MinFill is the lowest ratio of items to the capacity of a node.
+	MinFill float64
+	// MaxFill is the highest ratio of items to the capacity of a node.
+	MaxFill float64
+	// AvgItems is the average number of items per node.
+	AvgItems float64
+}
+
+// Stats walks the tree once and returns its metrics.
+// All metrics are zero if the tree has no items.
+func (tr *BTree[T]) Stats() TreeStats {
+	var stats TreeStats
+	if tr.root == nil {
+		return stats
+	}
+	stats.MinFill = 1
+	tr.nodeStats(tr.root, 1, &stats)
+	stats.AvgItems = float64(stats.Items) / float64(stats.Nodes)
+	return stats
+}
+
+func (tr *BTree[T]) nodeStats(n *node[T], depth int, stats *TreeStats) {
+	stats.Nodes++
+	stats.Items += len(n.items)
+	if depth > stats.Height {
+		stats.Height = depth
+	}
+	fill := float64(len(n.items)) / float64(tr.max)
+	if fill < stats.MinFill {
+		stats.MinFill = fill
+	}
+	if fill > stats.MaxFill {
+		stats.MaxFill = fill
+	}
+	if !n.leaf() {
+		for _, child := range *n.children {
+			tr.nodeStats(child, depth+1, stats)
+		}
+	}
+}
+
+// ScanBatch iterates over all items in tree in ascending order, passing them
+// in contiguous batches: the items of each leaf, and single items separating
+// leaves. Concatenating all batches yields the same items as Values.
+// The batches are read-only views of the tree which are only valid during
+// the call of iter, so they must be copied to be retained.
+// Return false to stop iterating
+func (tr *BTree[T]) ScanBatch(iter func(items []T) bool) {
+	tr.walk(iter, false)
+}
+
 // Walk iterates over all items in tree, in order.
 // The items param will contain one or more items.
 func (tr *BTree[T]) Walk(iter func(item []T) bool) {
@@ -995,7 +1409,7 @@ func (tr *BTree[T]) nodeWalk(cn **node[T], iter func(item []T) bool, mut bool,
 ) bool {
 	n := tr.isoLoad(cn, mut)
 	if n.leaf() {
-		if !iter(n.items) {
+		if !iter(n.items[:len(n.items):len(n.items)]) {
 			return false
 		}
 	} else {
@@ -1003,7 +1417,7 @@ func (tr *BTree[T]) nodeWalk(cn **node[T], iter func(item []T) bool, mut bool,
 			if !tr.nodeWalk(&(*n.children)[i], iter, mut) {
 				return false
 			}
-			if !iter(n.items[i : i+1]) {
+			if !iter(n.items[i : i+1 : i+1]) {
 				return false
 			}
 		}
@@ -1028,6 +1442,129 @@ func (tr *BTree[T]) IsoCopy() *BTree[T] {
 	return tr2
 }
 
+// Union returns a new tree of items which are in either tr or other.
+// For equal items, the one from tr is kept.
+// Both trees must be created with the same less function.
+func (tr *BTree[T]) Union(other *BTree[T]) *BTree[T] {
+	items := make([]T, 0, tr.Len()+other.Len())
+	iter1, iter2 := tr.Iter(), other.Iter()
+	ok1, ok2 := iter1.First(), iter2.First()
+	for ok1 && ok2 {
+		switch {
+		case tr.less(iter1.Item(), iter2.Item()):
+			items = append(items, iter1.Item())
+			ok1 = iter1.Next()
+		case tr.less(iter2.Item(), iter1.Item()):
+			items = append(items, iter2.Item())
+			ok2 = iter2.Next()
+		default:
+			items = append(items, iter1.Item())
+			ok1, ok2 = iter1.Next(), iter2.Next()
+		}
+	}
+	for ; ok1; ok1 = iter1.Next() {
+		items = append(items, iter1.Item())
+	}
+	for ; ok2; ok2 = iter2.Next() {
+		items = append(items, iter2.Item())
+	}
+	return tr.newFromSorted(items)
+}
+
+// Intersect returns a new tree of items which are in both tr and other.
+// For equal items, the one from tr is kept.
+// Both trees must be created with the same less function.
+func (tr *BTree[T]) Intersect(other *BTree[T]) *BTree[T] {
+	var items []T
+	iter1, iter2 := tr.Iter(), other.Iter()
+	ok1, ok2 := iter1.First(), iter2.First()
+	for ok1 && ok2 {
+		switch {
+		case tr.less(iter1.Item(), iter2.Item()):
+			ok1 = iter1.Next()
+		case tr.less(iter2.Item(), iter1.Item()):
+			ok2 = iter2.Next()
+		default:
+			items = append(items, iter1.Item())
+			ok1, ok2 = iter1.Next(), iter2.Next()
+		}
+	}
+	return tr.newFromSorted(items)
+}
+
+// Equal returns true if tr and other contain the same items in the same
+// order according to eq.
+func (tr *BTree[T]) Equal(other *BTree[T], eq func(a, b T) bool) bool {
+	if tr.Len() != other.Len() {
+		return false
+	}
+	iter1, iter2 := tr.Iter(), other.Iter()
+	ok1, ok2 := iter1.First(), iter2.First()
+	for ok1 && ok2 {
+		if !eq(iter1.Item(), iter2.Item()) {
+			return false
+		}
+		ok1, ok2 = iter1.Next(), iter2.Next()
+	}
+	return ok1 == ok2
+}
+
+// newFromSorted returns a new tree with the same less function and degree
+// as tr from items sorted in ascending order.
+func (tr *BTree[T]) newFromSorted(items []T) *BTree[T] {
+	tr2 := NewBTreeOptions(tr.less, Options{Degree: (tr.max + 1) / 2})
+	tr2.loadSorted(items)
+	return tr2
+}
+
+// Snapshot returns a read-only view of the tree. It's the same as Copy,
+// so it's very fast and later changes to tr don't affect the snapshot.
+func (tr *BTree[T]) Snapshot() *BTree[T] {
+	return tr.IsoCopy()
+}
+
+// ReplaceWith replaces all items of tr with items of other in O(1).
+// Snapshots taken from tr before the replacement keep the old items.
+// Both trees must be created with the same less function.
+// Like other mutations, it must not be called concurrently with other
+// operations on tr.
+func (tr *BTree[T]) ReplaceWith(other *BTree[T]) {
+	if tr == other {
+		return
+	}
+	// nodes are shared between tr and other from now on,
+	// so both trees must copy them before mutating.
+	other.isoid = newIsoID()
+	tr.isoid = newIsoID()
+	tr.root = other.root
+	tr.count = other.count
+	tr.min, tr.max = other.min, other.max
+}
+
+// DeepCopy returns a copy of the tree which doesn't share any nodes with tr.
+// Unlike Copy, all nodes are copied immediately and items implementing
+// Copy() or IsoCopy() are copied eagerly, so the returned tree is fully
+// detached from tr.
+func (tr *BTree[T]) DeepCopy() *BTree[T] {
+	tr2 := new(BTree[T])
+	*tr2 = *tr
+	tr2.isoid = newIsoID()
+	if tr2.root != nil {
+		tr2.root = tr2.deepCopy(tr.root)
+	}
+	return tr2
+}
+
+func (tr *BTree[T]) deepCopy(n *node[T]) *node[T] {
+	n2 := tr.copy(n)
+	if !n2.leaf() {
+		for i, child := range *n2.children {
+			(*n2.children)[i] = tr.deepCopy(child)
+		}
+	}
+	return n2
+}
+
 // Values returns all the items in order.
 func (tr *BTree[T]) Values() []T {
 	return tr.items(false)
@@ -1063,6 +1600,195 @@ func (tr *BTree[T]) Clear() {
 	tr.count = 0
 }
 
+// Iterator represents an iterator in the tree. It's created by Iter.
+// The iterator is invalidated when the tree is modified.
+type Iterator[T any] struct {
+	tr      *BTree[T]
+	seeked  bool
+	atstart bool
+	atend   bool
+	stack   []iterStackItem[T]
+	item    T
+}
+
+type iterStackItem[T any] struct {
+	n *node[T]
+	i int
+}
+
+// Iter returns a read-only iterator.
+func (tr *BTree[T]) Iter() Iterator[T] {
+	return Iterator[T]{tr: tr}
+}
+
+// Seek to item greater-or-equal-to key.
+// Returns false if there was no item found.
+func (iter *Iterator[T]) Seek(key T) bool {
+	iter.reset()
+	if iter.tr.root == nil {
+		return false
+	}
+	n := iter.tr.root
+	for {
+		i, found := iter.tr.bsearch(n, key)
+		iter.stack = append(iter.stack, iterStackItem[T]{n, i})
+		if found {
+			iter.item = n.items[i]
+			return true
+		}
+		if n.leaf() {
+			iter.stack[len(iter.stack)-1].i--
+			return iter.Next()
+		}
+		n = (*n.children)[i]
+	}
+}
+
+// First moves iterator to first item in tree.
+// Returns false if the tree is empty.
+func (iter *Iterator[T]) First() bool {
+	iter.reset()
+	if iter.tr.root == nil {
+		return false
+	}
+	n := iter.tr.root
+	for {
+		iter.stack = append(iter.stack, iterStackItem[T]{n, 0})
+		if n.leaf() {
+			break
+		}
+		n = (*n.children)[0]
+	}
+	s := &iter.stack[len(iter.stack)-1]
+	iter.item = s.n.items[s.i]
+	return true
+}
+
+// Last moves iterator to last item in tree.
+// Returns false if the tree is empty.
+func (iter *Iterator[T]) Last() bool {
+	iter.reset()
+	if iter.tr.root == nil {
+		return false
+	}
+	n := iter.tr.root
+	for {
+		iter.stack = append(iter.stack, iterStackItem[T]{n, len(n.items)})
+		if n.leaf() {
+			iter.stack[len(iter.stack)-1].i--
+			break
+		}
+		n = (*n.children)[len(n.items)]
+	}
+	s := &iter.stack[len(iter.stack)-1]
+	iter.item = s.n.items[s.i]
+	return true
+}
+
+// Next moves iterator to the next item in iterator.
+// Returns false if the tree is empty or the iterator is at the end of
+// the tree.
+func (iter *Iterator[T]) Next() bool {
+	if !iter.seeked {
+		return iter.First()
+	}
+	if len(iter.stack) == 0 {
+		if iter.atstart {
+			return iter.First()
+		}
+		return false
+	}
+	s := &iter.stack[len(iter.stack)-1]
+	s.i++
+	if s.n.leaf() {
+		if s.i == len(s.n.items) {
+			for {
+				iter.stack = iter.stack[:len(iter.stack)-1]
+				if len(iter.stack) == 0 {
+					iter.atend = true
+					return false
+				}
+				s = &iter.stack[len(iter.stack)-1]
+				if s.i < len(s.n.items) {
+					break
+				}
+			}
+		}
+	} else {
+		n := (*s.n.children)[s.i]
+		for {
+			iter.stack = append(iter.stack, iterStackItem[T]{n, 0})
+			if n.leaf() {
+				break
+			}
+			n = (*n.children)[0]
+		}
+	}
+	s = &iter.stack[len(iter.stack)-1]
+	iter.item = s.n.items[s.i]
+	return true
+}
+
+// Prev moves iterator to the previous item in iterator.
+// Returns false if the tree is empty or the iterator is at the beginning of
+// the tree.
+func (iter *Iterator[T]) Prev() bool {
+	if !iter.seeked {
+		return false
+	}
+	if len(iter.stack) == 0 {
+		if iter.atend {
+			return iter.Last()
+		}
+		return false
+	}
+	s := &iter.stack[len(iter.stack)-1]
+	if s.n.leaf() {
+		s.i--
+		if s.i == -1 {
+			for {
+				iter.stack = iter.stack[:len(iter.stack)-1]
+				if len(iter.stack) == 0 {
+					iter.atstart = true
+					return false
+				}
+				s = &iter.stack[len(iter.stack)-1]
+				s.i--
+				if s.i > -1 {
+					break
+				}
+			}
+		}
+	} else {
+		n := (*s.n.children)[s.i]
+		for {
+			iter.stack = append(iter.stack, iterStackItem[T]{n, len(n.items)})
+			if n.leaf() {
+				iter.stack[len(iter.stack)-1].i--
+				break
+			}
+			n = (*n.children)[len(n.items)]
+		}
+	}
+	s = &iter.stack[len(iter.stack)-1]
+	iter.item = s.n.items[s.i]
+	return true
+}
+
+// Item returns the current iterator item.
+func (iter *Iterator[T]) Item() T {
+	return iter.item
+}
+
+// reset clears the position of the iterator before seeking.
+func (iter *Iterator[T]) reset() {
+	iter.seeked = true
+	iter.atstart = false
+	iter.atend = false
+	iter.stack = iter.stack[:0]
+	iter.item = iter.tr.empty
+}
+
 var gisoid uint64
 
 func newIsoID() uint64 {
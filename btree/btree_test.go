@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"sort"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -190,6 +191,94 @@ func TestGenericAscend(t *testing.T) {
 	}
 }
 
+func TestGenericAscendRange(t *testing.T) {
+	tr := testNewBTree()
+	for i := 0; i < 1000; i += 10 {
+		tr.Upsert(testMakeItem(i))
+	}
+	testCases := map[string]struct {
+		greaterOrEqual, lessThan int
+		expected                 []testKind
+	}{
+		"should include the lower bound and exclude the upper bound": {
+			greaterOrEqual: 100,
+			lessThan:       140,
+			expected:       []testKind{100, 110, 120, 130},
+		},
+		"should work with bounds between items": {
+			greaterOrEqual: 95,
+			lessThan:       135,
+			expected:       []testKind{100, 110, 120, 130},
+		},
+		"should stop at the end of the tree": {
+			greaterOrEqual: 975,
+			lessThan:       2000,
+			expected:       []testKind{980, 990},
+		},
+		"should return nothing for an empty range": {
+			greaterOrEqual: 100,
+			lessThan:       100,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var all []testKind
+			tr.AscendRange(testMakeItem(tc.greaterOrEqual), testMakeItem(tc.lessThan), func(item testKind) bool {
+				all = append(all, item)
+				return true
+			})
+			if !kindsAreEqual(tc.expected, all) {
+				t.Fatalf("expected %v, got %v", tc.expected, all)
+			}
+		})
+	}
+}
+
+func TestGenericDescendRange(t *testing.T) {
+	tr := testNewBTree()
+	for i := 0; i < 1000; i += 10 {
+		tr.Upsert(testMakeItem(i))
+	}
+	testCases := map[string]struct {
+		lessOrEqual, greaterThan int
+		expected                 []testKind
+	}{
+		"should include the upper bound and exclude the lower bound": {
+			lessOrEqual: 140,
+			greaterThan: 100,
+			expected:    []testKind{140, 130, 120, 110},
+		},
+		"should work with bounds between items": {
+			lessOrEqual: 145,
+			greaterThan: 105,
+			expected:    []testKind{140, 130, 120, 110},
+		},
+		"should stop at the beginning of the tree": {
+			lessOrEqual: 15,
+			greaterThan: -100,
+			expected:    []testKind{10, 0},
+		},
+		"should return nothing for an empty range": {
+			lessOrEqual: 100,
+			greaterThan: 100,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var all []testKind
+			tr.DescendRange(testMakeItem(tc.lessOrEqual), testMakeItem(tc.greaterThan), func(item testKind) bool {
+				all = append(all, item)
+				return true
+			})
+			if !kindsAreEqual(tc.expected, all) {
+				t.Fatalf("expected %v, got %v", tc.expected, all)
+			}
+		})
+	}
+}
+
 func TestGenericItems(t *testing.T) {
 	tr := testNewBTree()
 	if len(tr.Values()) != 0 {
@@ -776,6 +865,39 @@ func TestGenericRandom(t *testing.T) {
 	tr.sane()
 }
 
+func TestGenericLoadMany(t *testing.T) {
+	tr := testNewBTree()
+	var items []testKind
+	for i := 0; i < 1000; i++ {
+		items = append(items, testMakeItem(i))
+		if i%3 == 0 {
+			items = append(items, testMakeItem(i), testMakeItem(i))
+		}
+	}
+	assert(t, tr.LoadMany(items) == 1000)
+	tr.sane()
+	assert(t, tr.Len() == 1000)
+	for i := 0; i < 1000; i++ {
+		v, ok := tr.GetAt(i)
+		assert(t, ok && tr.eq(v, testMakeItem(i)))
+	}
+
+	// items which already exist aren't counted.
+	assert(t, tr.LoadMany([]testKind{testMakeItem(999), testMakeItem(1000), testMakeItem(1001)}) == 2)
+	assert(t, tr.Len() == 1002)
+	tr.sane()
+
+	type pair struct {
+		key, value int
+	}
+	tr2 := NewBTreeFunc(func(a, b pair) bool {
+		return a.key < b.key
+	})
+	assert(t, tr2.LoadMany([]pair{{1, 1}, {1, 2}, {2, 1}}) == 2)
+	v, _ := tr2.Get(pair{key: 1})
+	assert(t, v.value == 1)
+}
+
 func TestGenericLess(t *testing.T) {
 	tr := testNewBTree()
 	if !tr.less(testMakeItem(1), testMakeItem(2)) {
@@ -836,6 +958,566 @@ func TestGenericDeleteAt(t *testing.T) {
 	}
 }
 
+func TestGenericSetMany(t *testing.T) {
+	N := 1_000
+	tr := testNewBTree()
+	tr.SetMany(randKeys(N))
+	tr.SetMany(randKeys(N / 2))
+	tr.sane()
+	assert(t, tr.Len() == N)
+
+	keys := make([]testKind, 0, N)
+	for i := 0; i < N; i++ {
+		keys = append(keys, testMakeItem(i))
+	}
+	assert(t, kindsAreEqual(keys, tr.Values()))
+
+	deleted := tr.DeleteMany([]testKind{
+		testMakeItem(-1), testMakeItem(10), testMakeItem(11),
+		testMakeItem(10), testMakeItem(500), testMakeItem(N),
+	})
+	tr.sane()
+	assert(t, deleted == 3)
+	assert(t, tr.Len() == N-3)
+	assert(t, !tr.Contains(testMakeItem(10)) && !tr.Contains(testMakeItem(500)))
+
+	assert(t, tr.DeleteMany(randKeys(N)) == N-3)
+	assert(t, tr.Len() == 0)
+}
+
+func TestGenericDeleteRange(t *testing.T) {
+	N := 1_000
+	tr := testNewBTree()
+	for _, key := range randKeys(N) {
+		tr.Upsert(key)
+	}
+	items := tr.Values()
+
+	assert(t, tr.DeleteRange(testMakeItem(500), testMakeItem(500)) == 0)
+	assert(t, tr.DeleteRange(testMakeItem(N), testMakeItem(N*2)) == 0)
+	assert(t, tr.Len() == N)
+
+	assert(t, tr.DeleteRange(testMakeItem(250), testMakeItem(750)) == 500)
+	tr.sane()
+	assert(t, tr.Len() == N-500)
+	assert(t, kindsAreEqual(append(items[:250:250], items[750:]...), tr.Values()))
+
+	assert(t, tr.DeleteRange(testMakeItem(-1), testMakeItem(N)) == N-500)
+	tr.sane()
+	assert(t, tr.Len() == 0)
+}
+
+func TestNewFromSorted(t *testing.T) {
+	for _, N := range []int{0, 1, 3, 4, 15, 16, 100, 1_000, 10_000} {
+		items := make([]testKind, N)
+		tr2 := NewBTree[testKind]()
+		for i := 0; i < N; i++ {
+			items[i] = testMakeItem(i)
+			tr2.Upsert(items[i])
+		}
+		tr := NewFromSorted(items)
+		tr.sane()
+		assert(t, tr.Len() == N)
+		assert(t, tr.Height() <= tr2.Height())
+		assert(t, kindsAreEqual(items, tr.Values()))
+
+		for i := 0; i < N; i += 2 {
+			tr.Delete(items[i])
+		}
+		tr.Upsert(testMakeItem(N))
+		tr.sane()
+	}
+
+	t.Run("should fall back to inserting unsorted items", func(t *testing.T) {
+		tr := NewFromSorted([]testKind{3, 1, 2, 1})
+		tr.sane()
+		assert(t, kindsAreEqual([]testKind{1, 2, 3}, tr.Values()))
+	})
+}
+
+func TestGenericUnion(t *testing.T) {
+	tr1 := testNewBTree()
+	tr2 := testNewBTree()
+	for i := 0; i < 1000; i += 2 {
+		tr1.Upsert(testMakeItem(i))
+	}
+	for i := 0; i < 1000; i += 3 {
+		tr2.Upsert(testMakeItem(i))
+	}
+
+	tr := tr1.Union(tr2)
+	tr.sane()
+	var expected []testKind
+	for i := 0; i < 1000; i++ {
+		if i%2 == 0 || i%3 == 0 {
+			expected = append(expected, testMakeItem(i))
+		}
+	}
+	assert(t, kindsAreEqual(expected, tr.Values()))
+	assert(t, tr1.Len() == 500 && tr2.Len() == 334)
+
+	tr = tr1.Union(testNewBTree())
+	assert(t, kindsAreEqual(tr1.Values(), tr.Values()))
+}
+
+func TestGenericEqual(t *testing.T) {
+	eq := func(a, b testKind) bool {
+		return a == b
+	}
+	tr1 := testNewBTree()
+	tr2 := testNewBTree()
+	assert(t, tr1.Equal(tr2, eq))
+	for i, key := range randKeys(1000) {
+		tr1.Upsert(key)
+		if i < 999 {
+			tr2.Upsert(key)
+		}
+	}
+	assert(t, !tr1.Equal(tr2, eq))
+	assert(t, !tr2.Equal(tr1, eq))
+
+	tr2 = tr1.DeepCopy()
+	assert(t, tr1.Equal(tr2, eq))
+
+	item, _ := tr2.GetAt(500)
+	tr2.Delete(item)
+	tr2.Upsert(testMakeItem(1000))
+	assert(t, tr1.Len() == tr2.Len())
+	assert(t, !tr1.Equal(tr2, eq))
+}
+
+func TestGenericIntersect(t *testing.T) {
+	tr1 := testNewBTree()
+	tr2 := testNewBTree()
+	for i := 0; i < 1000; i += 2 {
+		tr1.Upsert(testMakeItem(i))
+	}
+	for i := 0; i < 1000; i += 3 {
+		tr2.Upsert(testMakeItem(i))
+	}
+
+	tr := tr1.Intersect(tr2)
+	tr.sane()
+	var expected []testKind
+	for i := 0; i < 1000; i += 6 {
+		expected = append(expected, testMakeItem(i))
+	}
+	assert(t, kindsAreEqual(expected, tr.Values()))
+
+	tr = tr1.Intersect(testNewBTree())
+	assert(t, tr.Len() == 0)
+}
+
+func TestGenericMinMax(t *testing.T) {
+	tr := testNewBTree()
+	_, _, ok := tr.MinMax()
+	assert(t, !ok)
+	_, ok = tr.PopMin()
+	assert(t, !ok)
+	_, ok = tr.PopMax()
+	assert(t, !ok)
+
+	for _, key := range randKeys(1000) {
+		tr.Upsert(key)
+	}
+	min, max, ok := tr.MinMax()
+	assert(t, ok && tr.eq(min, testMakeItem(0)) && tr.eq(max, testMakeItem(999)))
+
+	item, ok := tr.PopMin()
+	assert(t, ok && tr.eq(item, testMakeItem(0)))
+	item, ok = tr.PopMax()
+	assert(t, ok && tr.eq(item, testMakeItem(999)))
+	tr.sane()
+
+	min, max, ok = tr.MinMax()
+	assert(t, ok && tr.eq(min, testMakeItem(1)) && tr.eq(max, testMakeItem(998)))
+	assert(t, tr.Len() == 998)
+}
+
+func TestGenericDeepCopy(t *testing.T) {
+	tr := NewBTreeFunc(func(a, b *testCopyItem) bool {
+		return a.data < b.data
+	})
+	for i := 0; i < 1000; i++ {
+		tr.Upsert(newTestCopyItem(fmt.Sprintf("%04d", i)))
+	}
+
+	tr2 := tr.DeepCopy()
+	assert(t, tr2.Len() == tr.Len())
+
+	// mutate all items of the original tree without copy-on-write.
+	tr.Scan(func(item *testCopyItem) bool {
+		item.data += "-changed"
+		return true
+	})
+	tr.Upsert(newTestCopyItem("new"))
+
+	i := 0
+	tr2.Scan(func(item *testCopyItem) bool {
+		assert(t, item.data == fmt.Sprintf("%04d", i))
+		i++
+		return true
+	})
+	assert(t, i == 1000)
+}
+
+func TestGenericRank(t *testing.T) {
+	tr := testNewBTree()
+	assert(t, tr.Rank(testMakeItem(0)) == 0)
+	for _, key := range randKeys(1000) {
+		tr.Upsert(key * 2)
+	}
+	for i := -1; i <= 2000; i++ {
+		assert(t, tr.Rank(testMakeItem(i)) == (i+1)/2)
+	}
+	for i := 0; i < 1000; i++ {
+		item, _ := tr.GetAt(tr.Rank(testMakeItem(i * 2)))
+		assert(t, tr.eq(item, testMakeItem(i*2)))
+	}
+}
+
+func TestGenericCountRange(t *testing.T) {
+	tr := testNewBTree()
+	for _, key := range randKeys(1000) {
+		tr.Upsert(key * 2)
+	}
+	testCases := map[string]struct {
+		low, high int
+		expected  int
+	}{
+		"should include low and exclude high":   {100, 200, 50},
+		"should work with bounds between items": {99, 201, 51},
+		"should count all items":                {-10, 5000, 1000},
+		"should return 0 for an empty range":    {200, 200, 0},
+		"should return 0 for an inverted range": {300, 200, 0},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			count := tr.CountRange(testMakeItem(tc.low), testMakeItem(tc.high))
+			if count != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, count)
+			}
+		})
+	}
+}
+
+func TestGenericScanPage(t *testing.T) {
+	N := 1000
+	tr := testNewBTree()
+	for _, key := range randKeys(N) {
+		tr.Upsert(key)
+	}
+	items := tr.Values()
+	page := func(offset, limit int) []testKind {
+		var all []testKind
+		tr.ScanPage(offset, limit, func(item testKind) bool {
+			all = append(all, item)
+			return true
+		})
+		return all
+	}
+
+	for offset := 0; offset < N; offset += 37 {
+		for _, limit := range []int{1, 10, 100} {
+			end := offset + limit
+			if end > N {
+				end = N
+			}
+			assert(t, kindsAreEqual(items[offset:end], page(offset, limit)))
+		}
+	}
+	assert(t, len(page(N, 10)) == 0)
+	assert(t, len(page(N*2, 10)) == 0)
+	assert(t, len(page(0, 0)) == 0)
+	assert(t, kindsAreEqual(items[N-5:], page(N-5, 100)))
+	assert(t, kindsAreEqual(items, page(-1, N*2)))
+
+	var count int
+	tr.ScanPage(10, 100, func(item testKind) bool {
+		count++
+		return count < 5
+	})
+	assert(t, count == 5)
+}
+
+func TestGenericReplaceWith(t *testing.T) {
+	tr := testNewBTree()
+	for i := 0; i < 1000; i++ {
+		tr.Upsert(testMakeItem(i))
+	}
+	snapshot := tr.Snapshot()
+
+	other := testNewBTree()
+	for i := 1000; i < 1500; i++ {
+		other.Upsert(testMakeItem(i))
+	}
+	tr.ReplaceWith(other)
+	assert(t, tr.Len() == 500)
+	assert(t, kindsAreEqual(other.Values(), tr.Values()))
+
+	// mutating either tree must not affect the other one or the snapshot.
+	tr.Delete(testMakeItem(1000))
+	other.Upsert(testMakeItem(2000))
+	tr.sane()
+	other.sane()
+	assert(t, tr.Len() == 499 && !tr.Contains(testMakeItem(2000)))
+	assert(t, other.Len() == 501 && other.Contains(testMakeItem(1000)))
+
+	snapshot.sane()
+	assert(t, snapshot.Len() == 1000)
+	for i := 0; i < 1000; i++ {
+		assert(t, snapshot.Contains(testMakeItem(i)))
+	}
+}
+
+func TestGenericGetOrInsert(t *testing.T) {
+	type pair struct {
+		key, value int
+	}
+	tr := NewBTreeFunc(func(a, b pair) bool {
+		return a.key < b.key
+	})
+
+	// BTree isn't synchronized, so concurrent callers share a lock.
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var inserted int32
+	results := make([]pair, 100)
+	for i := 0; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mu.Lock()
+			actual, loaded := tr.GetOrInsert(pair{key: 1, value: i})
+			mu.Unlock()
+			if !loaded {
+				atomic.AddInt32(&inserted, 1)
+			}
+			results[i] = actual
+		}(i)
+	}
+	wg.Wait()
+
+	assert(t, inserted == 1)
+	assert(t, tr.Len() == 1)
+	stored, _ := tr.Get(pair{key: 1})
+	for _, actual := range results {
+		assert(t, actual == stored)
+	}
+}
+
+func TestGenericGetRange(t *testing.T) {
+	tr := testNewBTree()
+	assert(t, len(tr.GetRange(0, 10)) == 0)
+	for _, key := range randKeys(1000) {
+		tr.Upsert(key)
+	}
+	items := tr.Values()
+
+	assert(t, kindsAreEqual(items[400:500], tr.GetRange(400, 500)))
+	assert(t, kindsAreEqual(items[950:], tr.GetRange(950, 1100)))
+	assert(t, kindsAreEqual(items[:10], tr.GetRange(-5, 10)))
+	assert(t, kindsAreEqual(items, tr.GetRange(0, 1000)))
+	assert(t, len(tr.GetRange(500, 500)) == 0)
+	assert(t, len(tr.GetRange(600, 500)) == 0)
+	assert(t, len(tr.GetRange(1000, 1010)) == 0)
+}
+
+func TestGenericStats(t *testing.T) {
+	tr := NewBTree[testKind]()
+	assert(t, tr.Stats() == TreeStats{})
+
+	// a full 2-3-4 tree with height 2 has 4 leaves of 3 items
+	// and a root of 3 items.
+	items := make([]testKind, 15)
+	for i := range items {
+		items[i] = testMakeItem(i)
+	}
+	tr = NewFromSorted(items)
+	stats := tr.Stats()
+	assert(t, stats == TreeStats{
+		Nodes:    5,
+		Height:   2,
+		Items:    15,
+		MinFill:  1,
+		MaxFill:  1,
+		AvgItems: 3,
+	})
+
+	tr.Delete(testMakeItem(0))
+	tr.Delete(testMakeItem(1))
+	stats = tr.Stats()
+	assert(t, stats.Nodes == 5 && stats.Height == 2 && stats.Items == 13)
+	assert(t, stats.MinFill == 1.0/3 && stats.MaxFill == 1)
+	assert(t, stats.AvgItems == 13.0/5)
+	assert(t, stats.Height == tr.Height())
+}
+
+func TestGenericContains(t *testing.T) {
+	N := 10_000
+	tr := testNewBTree()
+	assert(t, !tr.Contains(testMakeItem(0)))
+	for i := 0; i < N; i += 2 {
+		tr.Upsert(testMakeItem(i))
+	}
+	for i := 0; i < N; i++ {
+		assert(t, tr.Contains(testMakeItem(i)) == (i%2 == 0))
+	}
+}
+
+func TestGenericGetNeighbor(t *testing.T) {
+	tr := testNewBTree()
+	for i := 0; i < 1000; i += 10 {
+		tr.Upsert(testMakeItem(i))
+	}
+	type getFunc func(key testKind) (testKind, bool)
+	testCases := map[string]struct {
+		get      getFunc
+		key      int
+		expected int
+		ok       bool
+	}{
+		"GetGE should return the key if it exists":        {tr.GetGE, 100, 100, true},
+		"GetGE should return the next item in a gap":      {tr.GetGE, 101, 110, true},
+		"GetGE should return false after the max":         {tr.GetGE, 991, 0, false},
+		"GetGT should skip the key if it exists":          {tr.GetGT, 100, 110, true},
+		"GetGT should return the next item in a gap":      {tr.GetGT, 105, 110, true},
+		"GetGT should return false at the max":            {tr.GetGT, 990, 0, false},
+		"GetLE should return the key if it exists":        {tr.GetLE, 100, 100, true},
+		"GetLE should return the previous item in a gap":  {tr.GetLE, 109, 100, true},
+		"GetLE should return false before the min":        {tr.GetLE, -1, 0, false},
+		"GetLT should skip the key if it exists":          {tr.GetLT, 100, 90, true},
+		"GetLT should return the previous item in a gap":  {tr.GetLT, 95, 90, true},
+		"GetLT should return false at the min":            {tr.GetLT, 0, 0, false},
+		"GetLT should return the max for a key after it":  {tr.GetLT, 5000, 990, true},
+		"GetGT should return the min for a key before it": {tr.GetGT, -5, 0, true},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			item, ok := tc.get(testMakeItem(tc.key))
+			if ok != tc.ok || (ok && !tr.eq(item, testMakeItem(tc.expected))) {
+				t.Fatalf("expected %v, %v, got %v, %v", tc.expected, tc.ok, item, ok)
+			}
+		})
+	}
+
+	empty := testNewBTree()
+	if _, ok := empty.GetGE(testMakeItem(0)); ok {
+		t.Fatal("expected false for an empty tree")
+	}
+
+	for i := -5; i < 1000; i++ {
+		key := testMakeItem(i)
+		var ge, gt testKind
+		var geOK, gtOK bool
+		tr.Ascend(key, func(item testKind) bool {
+			if !geOK {
+				ge, geOK = item, true
+			}
+			if tr.lt(key, item) {
+				gt, gtOK = item, true
+				return false
+			}
+			return true
+		})
+		if item, ok := tr.GetGE(key); ok != geOK || (ok && !tr.eq(item, ge)) {
+			t.Fatalf("GetGE(%v): expected %v, %v, got %v, %v", key, ge, geOK, item, ok)
+		}
+		if item, ok := tr.GetGT(key); ok != gtOK || (ok && !tr.eq(item, gt)) {
+			t.Fatalf("GetGT(%v): expected %v, %v, got %v, %v", key, gt, gtOK, item, ok)
+		}
+	}
+}
+
+func TestGenericIter(t *testing.T) {
+	N := 1_000
+	tr := testNewBTree()
+	iter := tr.Iter()
+	assert(t, !iter.First() && !iter.Last() && !iter.Next() && !iter.Prev())
+	assert(t, !iter.Seek(testMakeItem(0)))
+
+	for _, key := range randKeys(N) {
+		tr.Upsert(key * 2)
+	}
+	items := tr.Values()
+
+	t.Run("should traverse forward and backward", func(t *testing.T) {
+		iter := tr.Iter()
+		var all []testKind
+		for iter.Next() {
+			all = append(all, iter.Item())
+		}
+		assert(t, kindsAreEqual(items, all))
+
+		all = all[:0]
+		for iter.Prev() {
+			all = append(all, iter.Item())
+		}
+		sort.Slice(all, func(i, j int) bool { return tr.lt(all[i], all[j]) })
+		assert(t, kindsAreEqual(items, all))
+		assert(t, iter.Next() && tr.eq(iter.Item(), items[0]))
+	})
+
+	t.Run("should switch directions", func(t *testing.T) {
+		iter := tr.Iter()
+		for i := 0; i < N; i++ {
+			assert(t, iter.Seek(items[i]) && tr.eq(iter.Item(), items[i]))
+			if i > 0 {
+				assert(t, iter.Prev() && tr.eq(iter.Item(), items[i-1]))
+				assert(t, iter.Next() && tr.eq(iter.Item(), items[i]))
+			}
+			if i < N-1 {
+				assert(t, iter.Next() && tr.eq(iter.Item(), items[i+1]))
+			}
+		}
+		assert(t, iter.Last() && tr.eq(iter.Item(), items[N-1]))
+		assert(t, !iter.Next())
+		assert(t, iter.Prev() && tr.eq(iter.Item(), items[N-1]))
+	})
+
+	t.Run("should seek to the next greater item of an absent key", func(t *testing.T) {
+		iter := tr.Iter()
+		for i := 0; i < N; i++ {
+			assert(t, iter.Seek(testMakeItem(i*2-1)) && tr.eq(iter.Item(), items[i]))
+		}
+		assert(t, !iter.Seek(testMakeItem(N*2)))
+		assert(t, iter.Prev() && tr.eq(iter.Item(), items[N-1]))
+	})
+}
+
+type largeItem struct {
+	key     int
+	payload [256]int
+}
+
+func newLargeItemBTree(n int) *BTree[largeItem] {
+	tr := NewBTreeFunc(func(a, b largeItem) bool {
+		return a.key < b.key
+	})
+	for i := 0; i < n; i++ {
+		tr.Upsert(largeItem{key: i})
+	}
+	return tr
+}
+
+func BenchmarkGenericGetLargeItem(b *testing.B) {
+	tr := newLargeItemBTree(10_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tr.Get(largeItem{key: i % 10_000})
+	}
+}
+
+func BenchmarkGenericContainsLargeItem(b *testing.B) {
+	tr := newLargeItemBTree(10_000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tr.Contains(largeItem{key: i % 10_000})
+	}
+}
+
 func TestGenericCopy(t *testing.T) {
 	items := randKeys(100000)
 	itemsM := testNewBTree()
@@ -1209,3 +1891,32 @@ func (tr *BTree[T]) saneorder() bool {
 	})
 	return !bad && count == tr.count
 }
+
+func TestGenericScanBatch(t *testing.T) {
+	tr := testNewBTree()
+	tr.ScanBatch(func(items []testKind) bool {
+		t.Fatal("should not be called on an empty tree")
+		return true
+	})
+
+	for _, key := range randKeys(10_000) {
+		tr.Upsert(key)
+	}
+	var all []testKind
+	batches := 0
+	tr.ScanBatch(func(items []testKind) bool {
+		assert(t, len(items) > 0 && cap(items) == len(items))
+		all = append(all, items...)
+		batches++
+		return true
+	})
+	assert(t, kindsAreEqual(all, tr.Values()))
+	assert(t, batches < tr.Len())
+
+	batches = 0
+	tr.ScanBatch(func(items []testKind) bool {
+		batches++
+		return batches < 3
+	})
+	assert(t, batches == 3)
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package btree
+
+import "iter"
+
+// All returns an iterator over all items in ascending order.
+func (tr *BTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		tr.Scan(yield)
+	}
+}
+
+// Backward returns an iterator over all items in descending order.
+func (tr *BTree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		tr.ReverseScan(yield)
+	}
+}
+
+// Range returns an iterator over items within the range [pivot, last]
+// in ascending order.
+func (tr *BTree[T]) Range(pivot T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		tr.Ascend(pivot, yield)
+	}
+}
@@ -0,0 +1,66 @@
+//go:build go1.23
+
+package btree
+
+import "testing"
+
+func TestGenericAll(t *testing.T) {
+	tr := testNewBTree()
+	for _, key := range randKeys(1000) {
+		tr.Upsert(key)
+	}
+	items := tr.Values()
+
+	t.Run("All should iterate all items in order", func(t *testing.T) {
+		var all []testKind
+		for item := range tr.All() {
+			all = append(all, item)
+		}
+		assert(t, kindsAreEqual(items, all))
+	})
+
+	t.Run("Backward should iterate all items in reverse order", func(t *testing.T) {
+		var all []testKind
+		for item := range tr.Backward() {
+			all = append([]testKind{item}, all...)
+		}
+		assert(t, kindsAreEqual(items, all))
+	})
+
+	t.Run("Range should iterate items from the pivot", func(t *testing.T) {
+		var all []testKind
+		for item := range tr.Range(testMakeItem(500)) {
+			all = append(all, item)
+		}
+		assert(t, kindsAreEqual(items[500:], all))
+	})
+
+	t.Run("should stop after break", func(t *testing.T) {
+		var all []testKind
+		for item := range tr.All() {
+			if len(all) == 10 {
+				break
+			}
+			all = append(all, item)
+		}
+		assert(t, kindsAreEqual(items[:10], all))
+
+		all = all[:0]
+		for item := range tr.Backward() {
+			if len(all) == 10 {
+				break
+			}
+			all = append(all, item)
+		}
+		assert(t, len(all) == 10 && tr.eq(all[0], items[len(items)-1]))
+
+		all = all[:0]
+		for item := range tr.Range(testMakeItem(500)) {
+			if len(all) == 10 {
+				break
+			}
+			all = append(all, item)
+		}
+		assert(t, kindsAreEqual(items[500:510], all))
+	})
+}
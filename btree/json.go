@@ -0,0 +1,30 @@
+package btree
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrMissingLess means the tree isn't created with a less function.
+var ErrMissingLess = errors.New("btree: less function is missing")
+
+// MarshalJSON encodes the tree as a JSON array of items in ascending order.
+func (tr *BTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tr.Values())
+}
+
+// UnmarshalJSON replaces items of the tree with items decoded from a JSON array.
+// As the less function can't be encoded, the tree must be created with a less
+// function before decoding, otherwise ErrMissingLess is returned.
+func (tr *BTree[T]) UnmarshalJSON(data []byte) error {
+	if tr.less == nil {
+		return ErrMissingLess
+	}
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	tr.Clear()
+	tr.loadSorted(items)
+	return nil
+}
@@ -0,0 +1,59 @@
+package btree
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testJSONItem struct {
+	Key   string `json:"key"`
+	Value int    `json:"value"`
+}
+
+func TestGenericJSON(t *testing.T) {
+	t.Run("should round-trip a tree of ints", func(t *testing.T) {
+		tr := testNewBTree()
+		for _, key := range randKeys(1000) {
+			tr.Upsert(key)
+		}
+		data, err := json.Marshal(tr)
+		assert(t, err == nil)
+
+		tr2 := testNewBTree()
+		tr2.Upsert(testMakeItem(-1))
+		assert(t, json.Unmarshal(data, tr2) == nil)
+		tr2.sane()
+		assert(t, kindsAreEqual(tr.Values(), tr2.Values()))
+	})
+
+	t.Run("should round-trip a tree of structs with a custom less", func(t *testing.T) {
+		less := func(a, b testJSONItem) bool {
+			return a.Key > b.Key
+		}
+		tr := NewBTreeFunc(less)
+		tr.Upsert(testJSONItem{"a", 1})
+		tr.Upsert(testJSONItem{"c", 3})
+		tr.Upsert(testJSONItem{"b", 2})
+		data, err := json.Marshal(tr)
+		assert(t, err == nil)
+		assert(t, string(data) == `[{"key":"c","value":3},{"key":"b","value":2},{"key":"a","value":1}]`)
+
+		tr2 := NewBTreeFunc(less)
+		assert(t, json.Unmarshal(data, tr2) == nil)
+		assert(t, tr2.Len() == 3)
+		item, ok := tr2.Get(testJSONItem{Key: "b"})
+		assert(t, ok && item.Value == 2)
+	})
+
+	t.Run("should fall back to inserting unsorted items", func(t *testing.T) {
+		tr := testNewBTree()
+		assert(t, json.Unmarshal([]byte(`[3, 1, 2, 1]`), tr) == nil)
+		tr.sane()
+		assert(t, kindsAreEqual([]testKind{1, 2, 3}, tr.Values()))
+	})
+
+	t.Run("should return an error without a less function", func(t *testing.T) {
+		var tr BTree[int]
+		assert(t, json.Unmarshal([]byte(`[1, 2]`), &tr) == ErrMissingLess)
+	})
+}
@@ -302,6 +302,25 @@ func (tr *Map[K, V]) get(key K, mut bool) (V, bool) {
 	}
 }
 
+// Contains returns whether the map has a value for key.
+// Unlike Get, it doesn't copy the found value.
+func (tr *Map[K, V]) Contains(key K) bool {
+	if tr.root == nil {
+		return false
+	}
+	n := tr.root
+	for {
+		i, found := tr.search(n, key)
+		if found {
+			return true
+		}
+		if n.leaf() {
+			return false
+		}
+		n = (*n.children)[i]
+	}
+}
+
 // Len returns the number of items in the tree
 func (tr *Map[K, V]) Len() int {
 	return tr.count
@@ -837,6 +837,18 @@ func TestMapDeleteAt(t *testing.T) {
 	}
 }
 
+func TestMapContains(t *testing.T) {
+	N := 10_000
+	tr := testMapNewBTree()
+	assert(t, !tr.Contains(testMapMakeItem(0)))
+	for i := 0; i < N; i += 2 {
+		tr.Set(testMapMakeItem(i), testMapMakeItem(i))
+	}
+	for i := 0; i < N; i++ {
+		assert(t, tr.Contains(testMapMakeItem(i)) == (i%2 == 0))
+	}
+}
+
 func TestMapVarious(t *testing.T) {
 	N := 1_000_000
 	tr := testMapNewBTree()
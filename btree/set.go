@@ -43,8 +43,7 @@ func (tr *Set[K]) Scan(iter func(key K) bool) {
 
 // Has checks whether a key exists or not.
 func (tr *Set[K]) Has(key K) bool {
-	_, ok := tr.base.Get(key)
-	return ok
+	return tr.base.Contains(key)
 }
 
 // Len returns the number of items in the tree
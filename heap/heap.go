@@ -17,6 +17,9 @@ type Element[T any] struct {
 // Heap represents a heap.
 type Heap[T comparable] struct {
 	container heapContainer[T]
+	// limit is the maximum number of elements kept in the heap.
+	// Zero means the heap is unbounded.
+	limit int
 }
 
 // New creates a new heap of T.
@@ -33,14 +36,75 @@ func NewFunc[T comparable](less algorithm.LessFunc[T]) *Heap[T] {
 	}
 }
 
+// NewBounded creates a new heap of T using less which keeps at most k elements.
+// When the heap is full, a pushed value displaces the top of the heap if it's
+// greater than the top, otherwise it's dropped. Therefore the heap keeps
+// the k greatest values and Top returns the least of them. The k must be positive.
+func NewBounded[T comparable](k int, less algorithm.LessFunc[T]) *Heap[T] {
+	if k <= 0 {
+		panic("heap: k must be positive")
+	}
+	h := NewFunc[T](less)
+	h.limit = k
+	return h
+}
+
+// NewFromSlice creates a new heap of T from items in O(n).
+// It returns the heap and the created elements in the same order as items.
+func NewFromSlice[T cmp.Ordered](items []T) (*Heap[T], []*Element[T]) {
+	return NewFuncFromSlice[T](items, cmp.Less[T])
+}
+
+// NewFuncFromSlice creates a new heap of T from items using less in O(n).
+// It returns the heap and the created elements in the same order as items.
+func NewFuncFromSlice[T comparable](items []T, less algorithm.LessFunc[T]) (*Heap[T], []*Element[T]) {
+	h := NewFunc[T](less)
+	elements := make([]*Element[T], len(items))
+	h.container.nodes = make([]*Element[T], len(items))
+	for i, item := range items {
+		elements[i] = &Element[T]{
+			Value: item,
+			index: i,
+		}
+		h.container.nodes[i] = elements[i]
+	}
+	heap.Init(&h.container)
+	return h, elements
+}
+
 // Push pushes a value into the heap.
 // It returns the created element for the provided value.
 func (h *Heap[T]) Push(value T) *Element[T] {
+	e, _ := h.TryPush(value)
+	return e
+}
+
+// TryPush pushes a value into the heap and reports whether it's retained.
+// The value is only dropped by a bounded heap, in which case the returned
+// element isn't in the heap.
+func (h *Heap[T]) TryPush(value T) (*Element[T], bool) {
 	newNode := &Element[T]{
 		Value: value,
 	}
+	if !h.makeRoom(value) {
+		newNode.index = -1
+		return newNode, false
+	}
 	heap.Push(&h.container, newNode)
-	return newNode
+	return newNode, true
+}
+
+// makeRoom pops the top of a full bounded heap if value should displace it.
+// It returns whether value should be pushed into the heap.
+func (h *Heap[T]) makeRoom(value T) bool {
+	if h.limit == 0 || h.Len() < h.limit {
+		return true
+	}
+	if !h.container.less(h.container.nodes[0].Value, value) {
+		return false
+	}
+	heap.Pop(&h.container)
+	return true
 }
 
 // Pop pops a value from the heap.
@@ -49,6 +113,34 @@ func (h *Heap[T]) Pop() T {
 	return val
 }
 
+// PushPop pushes value into the heap then pops the top of the heap.
+// It's more efficient than calling Push followed by Pop.
+func (h *Heap[T]) PushPop(value T) T {
+	if h.Len() == 0 || !h.container.less(h.container.nodes[0].Value, value) {
+		return value
+	}
+	return h.replaceTop(value)
+}
+
+// PopPush pops the top of the heap then pushes value into the heap.
+// It's more efficient than calling Pop followed by Push.
+// The heap must not be empty.
+func (h *Heap[T]) PopPush(value T) T {
+	return h.replaceTop(value)
+}
+
+// replaceTop replaces the top element with a new element of value
+// and returns the value of the replaced element.
+func (h *Heap[T]) replaceTop(value T) T {
+	top := h.container.nodes[0]
+	top.index = -1 // for safety
+	h.container.nodes[0] = &Element[T]{
+		Value: value,
+	}
+	heap.Fix(&h.container, 0)
+	return top.Value
+}
+
 // Top returns the element at the top of the heap.
 func (h *Heap[T]) Top() *Element[T] {
 	return h.container.nodes[0]
@@ -60,11 +152,127 @@ func (h *Heap[T]) Fix(e *Element[T]) {
 	heap.Fix(&h.container, e.index)
 }
 
+// Update changes the value of e and fixes its position in the heap.
+// If e is no longer in the heap, only its value is changed.
+func (h *Heap[T]) Update(e *Element[T], value T) {
+	e.Value = value
+	if h.contains(e) {
+		heap.Fix(&h.container, e.index)
+	}
+}
+
+// Remove removes e from the heap and returns its value.
+// If e is no longer in the heap, the heap is not modified.
+// The element must not be nil.
+func (h *Heap[T]) Remove(e *Element[T]) T {
+	if !h.contains(e) {
+		return e.Value
+	}
+	return heap.Remove(&h.container, e.index).(*Element[T]).Value
+}
+
+// Clear removes all elements from the heap.
+// The capacity of the underlying array is retained for later pushes.
+func (h *Heap[T]) Clear() {
+	for i, e := range h.container.nodes {
+		e.index = -1               // for safety
+		h.container.nodes[i] = nil // avoid memory leak
+	}
+	h.container.nodes = h.container.nodes[:0]
+}
+
+// Clone returns an independent copy of the heap.
+// Elements of the returned heap are distinct from the original ones,
+// so handles from the original heap must not be used with the clone.
+func (h *Heap[T]) Clone() *Heap[T] {
+	h2 := NewFunc[T](h.container.less)
+	h2.limit = h.limit
+	h2.container.nodes = make([]*Element[T], len(h.container.nodes), cap(h.container.nodes))
+	for i, e := range h.container.nodes {
+		e2 := *e
+		h2.container.nodes[i] = &e2
+	}
+	return h2
+}
+
+// Merge moves all elements of other into the heap in O(n), leaving other empty.
+// Elements of other remain valid handles of the heap. If the heap is bounded,
+// the least elements are dropped to keep its limit.
+// Both heaps must be created with the same less function.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	if h == other {
+		return
+	}
+	for _, e := range other.container.nodes {
+		e.index = len(h.container.nodes)
+		h.container.nodes = append(h.container.nodes, e)
+	}
+	other.container.nodes = nil
+	heap.Init(&h.container)
+	for h.limit > 0 && h.Len() > h.limit {
+		heap.Pop(&h.container)
+	}
+}
+
+// ElementAt returns the element at index i of the internal array of the heap,
+// where 0 is the top, and false if i is out of range.
+// It's intended to be used for debugging.
+func (h *Heap[T]) ElementAt(i int) (*Element[T], bool) {
+	if i < 0 || i >= len(h.container.nodes) {
+		return nil, false
+	}
+	return h.container.nodes[i], true
+}
+
+// Find returns an element of the heap whose value equals value.
+// It scans all elements, so the complexity is O(n).
+func (h *Heap[T]) Find(value T) (*Element[T], bool) {
+	for _, e := range h.container.nodes {
+		if e.Value == value {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// Contains returns whether the heap has an element whose value equals value.
+// It scans all elements, so the complexity is O(n).
+func (h *Heap[T]) Contains(value T) bool {
+	_, found := h.Find(value)
+	return found
+}
+
+// Values returns a copy of the values in the internal order of the heap.
+// It's intended to be used for debugging.
+func (h *Heap[T]) Values() []T {
+	values := make([]T, len(h.container.nodes))
+	for i, e := range h.container.nodes {
+		values[i] = e.Value
+	}
+	return values
+}
+
+// Sorted returns a copy of the values in the order they would be popped.
+// The heap isn't modified.
+func (h *Heap[T]) Sorted() []T {
+	clone := h.Clone()
+	values := make([]T, 0, clone.Len())
+	for clone.Len() > 0 {
+		values = append(values, clone.Pop())
+	}
+	return values
+}
+
 // Size returns the size of the queue.
 func (h *Heap[T]) Len() int {
 	return len(h.container.nodes)
 }
 
+// contains returns whether e is an element of the heap.
+func (h *Heap[T]) contains(e *Element[T]) bool {
+	return e.index >= 0 && e.index < len(h.container.nodes) && h.container.nodes[e.index] == e
+}
+
 type heapContainer[T any] struct {
 	nodes []*Element[T]
 	less  algorithm.LessFunc[T]
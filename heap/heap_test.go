@@ -1,6 +1,7 @@
 package heap_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/bongnv/go-container/heap"
@@ -51,3 +52,376 @@ func TestHeap(t *testing.T) {
 		})
 	}
 }
+
+func TestHeap_Remove(t *testing.T) {
+	t.Run("should maintain the heap order after removing a middle element", func(t *testing.T) {
+		h := heap.New[int]()
+		h.Push(5)
+		h.Push(1)
+		three := h.Push(3)
+		h.Push(4)
+		h.Push(2)
+
+		if v := h.Remove(three); v != 3 {
+			t.Fatalf("expected 3 but got %v", v)
+		}
+
+		popped := make([]int, 0, h.Len())
+		for h.Len() > 0 {
+			popped = append(popped, h.Pop())
+		}
+		if diff := cmp.Diff(popped, []int{1, 2, 4, 5}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should ignore a stale element", func(t *testing.T) {
+		h := heap.New[int]()
+		one := h.Push(1)
+		h.Push(2)
+		h.Remove(one)
+
+		if v := h.Remove(one); v != 1 {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+		if h.Len() != 1 {
+			t.Fatalf("expected 1 but got %v", h.Len())
+		}
+		if h.Top().Value != 2 {
+			t.Fatalf("expected 2 but got %v", h.Top().Value)
+		}
+	})
+}
+
+func TestHeap_Update(t *testing.T) {
+	testCases := map[string]struct {
+		scenario      func(h *heap.Heap[int])
+		expectedOrder []int
+	}{
+		"should move an element up after decreasing it": {
+			scenario: func(h *heap.Heap[int]) {
+				h.Push(1)
+				h.Push(2)
+				five := h.Push(5)
+				h.Update(five, 0)
+			},
+			expectedOrder: []int{0, 1, 2},
+		},
+		"should move an element down after increasing it": {
+			scenario: func(h *heap.Heap[int]) {
+				one := h.Push(1)
+				h.Push(2)
+				h.Push(5)
+				h.Update(one, 3)
+			},
+			expectedOrder: []int{2, 3, 5},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			h := heap.New[int]()
+			tc.scenario(h)
+			popped := make([]int, 0, h.Len())
+			for h.Len() > 0 {
+				popped = append(popped, h.Pop())
+			}
+			if diff := cmp.Diff(popped, tc.expectedOrder); diff != "" {
+				t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFromSlice(t *testing.T) {
+	h, elements := heap.NewFromSlice([]int{5, 3, 4, 1, 2})
+	if diff := cmp.Diff(len(elements), 5); diff != "" {
+		t.Fatalf("Unexpected number of elements, (+got|-wanted): %s", diff)
+	}
+	if elements[1].Value != 3 {
+		t.Fatalf("expected 3 but got %v", elements[1].Value)
+	}
+
+	h.Update(elements[1], 0)
+	popped := make([]int, 0, h.Len())
+	for h.Len() > 0 {
+		popped = append(popped, h.Pop())
+	}
+	if diff := cmp.Diff(popped, []int{0, 1, 2, 4, 5}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func BenchmarkNewFromSlice(b *testing.B) {
+	items := rand.Perm(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		heap.NewFromSlice(items)
+	}
+}
+
+func BenchmarkPush(b *testing.B) {
+	items := rand.Perm(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := heap.New[int]()
+		for _, item := range items {
+			h.Push(item)
+		}
+	}
+}
+
+func TestHeap_Clear(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(3)
+	one := h.Push(1)
+	h.Clear()
+	if h.Len() != 0 {
+		t.Fatalf("expected 0 but got %v", h.Len())
+	}
+
+	h.Remove(one)
+	h.Push(2)
+	h.Push(4)
+	if h.Len() != 2 {
+		t.Fatalf("expected 2 but got %v", h.Len())
+	}
+	if v := h.Pop(); v != 2 {
+		t.Fatalf("expected 2 but got %v", v)
+	}
+}
+
+func TestHeap_PushPop(t *testing.T) {
+	t.Run("should keep the largest k values like the streaming top-k idiom", func(t *testing.T) {
+		k := 3
+		h := heap.New[int]()
+		for _, v := range []int{5, 1, 9, 3, 7, 8, 2} {
+			if h.Len() < k {
+				h.Push(v)
+				continue
+			}
+			h.PushPop(v)
+		}
+
+		popped := make([]int, 0, h.Len())
+		for h.Len() > 0 {
+			popped = append(popped, h.Pop())
+		}
+		if diff := cmp.Diff(popped, []int{7, 8, 9}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should return the pushed value if it's the top", func(t *testing.T) {
+		h := heap.New[int]()
+		if v := h.PushPop(1); v != 1 {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+		h.Push(2)
+		if v := h.PushPop(1); v != 1 {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+		if h.Len() != 1 {
+			t.Fatalf("expected 1 but got %v", h.Len())
+		}
+	})
+}
+
+func TestHeap_PopPush(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(2)
+	h.Push(3)
+	if v := h.PopPush(1); v != 2 {
+		t.Fatalf("expected 2 but got %v", v)
+	}
+	if v := h.PopPush(5); v != 1 {
+		t.Fatalf("expected 1 but got %v", v)
+	}
+
+	popped := make([]int, 0, h.Len())
+	for h.Len() > 0 {
+		popped = append(popped, h.Pop())
+	}
+	if diff := cmp.Diff(popped, []int{3, 5}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestHeap_Clone(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(3)
+	one := h.Push(1)
+	h.Push(2)
+
+	clone := h.Clone()
+	if v := clone.Pop(); v != 1 {
+		t.Fatalf("expected 1 but got %v", v)
+	}
+	clone.Push(0)
+
+	if h.Len() != 3 {
+		t.Fatalf("expected 3 but got %v", h.Len())
+	}
+	if h.Top() != one {
+		t.Fatalf("expected the original top to be kept")
+	}
+
+	popped := make([]int, 0, h.Len())
+	for h.Len() > 0 {
+		popped = append(popped, h.Pop())
+	}
+	if diff := cmp.Diff(popped, []int{1, 2, 3}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestHeap_Values(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(3)
+	h.Push(1)
+	h.Push(2)
+
+	values := h.Values()
+	if diff := cmp.Diff(values, []int{1, 3, 2}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+
+	sorted := h.Sorted()
+	if diff := cmp.Diff(sorted, []int{1, 2, 3}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+
+	if diff := cmp.Diff(h.Values(), values); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestHeap_Merge(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(4)
+	h.Push(1)
+	other := heap.New[int]()
+	three := other.Push(3)
+	other.Push(2)
+
+	h.Merge(other)
+	if other.Len() != 0 {
+		t.Fatalf("expected 0 but got %v", other.Len())
+	}
+
+	h.Update(three, 0)
+	if diff := cmp.Diff(h.Sorted(), []int{0, 1, 2, 4}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestHeap_Contains(t *testing.T) {
+	h := heap.New[int]()
+	h.Push(3)
+	one := h.Push(1)
+	h.Push(2)
+
+	if !h.Contains(2) || !h.Contains(1) {
+		t.Fatalf("expected values to be present")
+	}
+	if h.Contains(4) {
+		t.Fatalf("expected 4 to be absent")
+	}
+
+	e, found := h.Find(1)
+	if !found || e != one {
+		t.Fatalf("expected to find the element of 1")
+	}
+	h.Update(e, 5)
+	if v := h.Pop(); v != 2 {
+		t.Fatalf("expected 2 but got %v", v)
+	}
+
+	h.Remove(one)
+	if e, found := h.Find(5); found || e != nil {
+		t.Fatalf("expected 5 to be absent after removing")
+	}
+}
+
+func TestHeap_ElementAt(t *testing.T) {
+	h, elements := heap.NewFromSlice([]int{5, 3, 4, 1, 2})
+	values := h.Values()
+
+	top, ok := h.ElementAt(0)
+	if !ok || top != h.Top() || top != elements[3] {
+		t.Fatalf("expected the top element at 0")
+	}
+	middle, ok := h.ElementAt(2)
+	if !ok || middle.Value != values[2] {
+		t.Fatalf("expected %v at 2", values[2])
+	}
+	for _, i := range []int{-1, 5} {
+		if e, ok := h.ElementAt(i); ok || e != nil {
+			t.Fatalf("expected no element at %v", i)
+		}
+	}
+}
+
+func TestNewBounded(t *testing.T) {
+	t.Run("should keep the largest k values of a stream", func(t *testing.T) {
+		h := heap.NewBounded[int](3, func(x, y int) bool { return x < y })
+		retained := map[int]*heap.Element[int]{}
+		var dropped []int
+		for _, v := range []int{5, 1, 9, 3, 7, 8, 2} {
+			e, ok := h.TryPush(v)
+			if ok {
+				retained[v] = e
+			} else {
+				dropped = append(dropped, v)
+			}
+			if h.Len() > 3 {
+				t.Fatalf("expected at most 3 elements but got %v", h.Len())
+			}
+		}
+		if diff := cmp.Diff(dropped, []int{2}); diff != "" {
+			t.Errorf("Unexpected dropped values, (+got|-wanted): %s", diff)
+		}
+
+		// 5 was retained but displaced later, so its handle is stale.
+		h.Update(retained[5], 10)
+		h.Update(retained[8], 6)
+		if diff := cmp.Diff(h.Sorted(), []int{6, 7, 9}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should drop values which aren't greater than the top", func(t *testing.T) {
+		h := heap.NewBounded[int](2, func(x, y int) bool { return x < y })
+		h.Push(1)
+		h.Push(2)
+		if _, ok := h.TryPush(1); ok {
+			t.Fatalf("expected 1 to be dropped")
+		}
+		h.Push(0)
+		if diff := cmp.Diff(h.Sorted(), []int{1, 2}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should keep the limit after merging", func(t *testing.T) {
+		h := heap.NewBounded[int](2, func(x, y int) bool { return x < y })
+		h.Push(1)
+		other, _ := heap.NewFromSlice([]int{3, 2, 5})
+		h.Merge(other)
+		if diff := cmp.Diff(h.Sorted(), []int{3, 5}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should panic if k isn't positive", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		heap.NewBounded[int](0, func(x, y int) bool { return x < y })
+	})
+}
@@ -0,0 +1,49 @@
+package heap
+
+import (
+	"container/heap"
+
+	"github.com/bongnv/go-container/algorithm"
+)
+
+// HeapSort sorts values in place using less.
+// It builds a heap over values and repeatedly moves the top to the end,
+// so it runs in O(n log n) without copying values.
+func HeapSort[T any](values []T, less algorithm.LessFunc[T]) {
+	sc := &sortContainer[T]{
+		values: values,
+		less:   less,
+	}
+	heap.Init(sc)
+	for sc.Len() > 1 {
+		heap.Pop(sc)
+	}
+}
+
+// sortContainer is a max-heap over values. Popping shrinks the heap
+// and leaves the popped value right after it in the backing array.
+type sortContainer[T any] struct {
+	values []T
+	less   algorithm.LessFunc[T]
+}
+
+func (sc sortContainer[T]) Len() int {
+	return len(sc.values)
+}
+
+func (sc sortContainer[T]) Less(i, j int) bool {
+	return sc.less(sc.values[j], sc.values[i])
+}
+
+func (sc sortContainer[T]) Swap(i, j int) {
+	sc.values[i], sc.values[j] = sc.values[j], sc.values[i]
+}
+
+func (sc *sortContainer[T]) Push(x any) {
+	panic("heap: push isn't supported while sorting")
+}
+
+func (sc *sortContainer[T]) Pop() any {
+	sc.values = sc.values[:len(sc.values)-1]
+	return nil
+}
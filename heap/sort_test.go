@@ -0,0 +1,75 @@
+package heap_test
+
+import (
+	"cmp"
+	"math/rand"
+	"testing"
+
+	"github.com/bongnv/go-container/algorithm"
+	"github.com/bongnv/go-container/heap"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestHeapSort(t *testing.T) {
+	testCases := map[string]struct {
+		input    []int
+		expected []int
+	}{
+		"should be fine if the array is empty": {
+			input:    []int{},
+			expected: []int{},
+		},
+		"should be fine if the array is sorted": {
+			input:    []int{1, 2, 3},
+			expected: []int{1, 2, 3},
+		},
+		"should sort if the array isn't sorted": {
+			input:    []int{3, 1, 2, 1},
+			expected: []int{1, 1, 2, 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			heap.HeapSort(tc.input, cmp.Less[int])
+			if diff := gocmp.Diff(tc.expected, tc.input); diff != "" {
+				t.Fatalf("the array isn't sorted: %s", diff)
+			}
+		})
+	}
+
+	t.Run("should match algorithm.Sort on random inputs", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			input := rand.Perm(rand.Intn(200))
+			expected := append([]int{}, input...)
+			algorithm.Sort(expected)
+			heap.HeapSort(input, cmp.Less[int])
+			if diff := gocmp.Diff(expected, input); diff != "" {
+				t.Fatalf("the array isn't sorted: %s", diff)
+			}
+		}
+	})
+}
+
+func BenchmarkHeapSort(b *testing.B) {
+	input := rand.Perm(10000)
+	values := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		heap.HeapSort(values, cmp.Less[int])
+	}
+}
+
+func BenchmarkAlgorithmSort(b *testing.B) {
+	input := rand.Perm(10000)
+	values := make([]int, len(input))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		algorithm.Sort(values)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build go1.23
+
+package list
+
+import "iter"
+
+// All returns an iterator over values of list l from front to back.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over values of list l from back to front.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Elements returns an iterator over elements of list l from front to back.
+// The yielded element must not be removed from l during the iteration.
+func (l *List[T]) Elements() iter.Seq[*Element[T]] {
+	return func(yield func(*Element[T]) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
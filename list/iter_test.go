@@ -0,0 +1,74 @@
+//go:build go1.23
+
+package list_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/list"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestList_All(t *testing.T) {
+	l := list.New[string]()
+	l.PushBack("a")
+	l.PushBack("b")
+	l.PushBack("c")
+
+	t.Run("All should iterate values from front to back", func(t *testing.T) {
+		var values []string
+		for v := range l.All() {
+			values = append(values, v)
+		}
+		if diff := cmp.Diff(values, []string{"a", "b", "c"}); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("Backward should iterate values from back to front", func(t *testing.T) {
+		var values []string
+		for v := range l.Backward() {
+			values = append(values, v)
+		}
+		if diff := cmp.Diff(values, []string{"c", "b", "a"}); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("Elements should iterate elements from front to back", func(t *testing.T) {
+		var values []string
+		for e := range l.Elements() {
+			values = append(values, e.Value)
+			e.Value += "!"
+		}
+		if diff := cmp.Diff(values, []string{"a", "b", "c"}); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+		expectList(t, l, "a!", "b!", "c!")
+	})
+
+	t.Run("should stop after break", func(t *testing.T) {
+		var values []string
+		for v := range l.All() {
+			if len(values) == 1 {
+				break
+			}
+			values = append(values, v)
+		}
+		for v := range l.Backward() {
+			if len(values) == 2 {
+				break
+			}
+			values = append(values, v)
+		}
+		for e := range l.Elements() {
+			if len(values) == 3 {
+				break
+			}
+			values = append(values, e.Value)
+		}
+		if diff := cmp.Diff(values, []string{"a!", "c!", "a!"}); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+}
@@ -54,13 +54,37 @@ func (l *List[T]) Init() *List[T] {
 	return l
 }
 
+// Clear removes all elements from list l in O(1).
+// Removed elements must not be used with l afterward.
+func (l *List[T]) Clear() {
+	l.Init()
+}
+
 // New returns an initialized list.
 func New[T any]() *List[T] { return new(List[T]).Init() }
 
+// NewFromSlice returns an initialized list containing items in the same order.
+func NewFromSlice[T any](items []T) *List[T] {
+	l := New[T]()
+	for _, v := range items {
+		l.insertValue(v, l.root.prev)
+	}
+	return l
+}
+
 // Size returns the number of elements of list l.
 // The complexity is O(1).
 func (l *List[T]) Len() int { return l.len }
 
+// Values returns all values of list l from front to back.
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.len)
+	for e := l.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
 // Front returns the first element of list l or nil if the list is empty.
 func (l *List[T]) Front() *Element[T] {
 	if l.len == 0 {
@@ -77,6 +101,44 @@ func (l *List[T]) Back() *Element[T] {
 	return l.root.prev
 }
 
+// At returns the element at index of list l and whether index is in range.
+// It walks from the nearer end of the list, so the complexity is O(n).
+func (l *List[T]) At(index int) (*Element[T], bool) {
+	if index < 0 || index >= l.len {
+		return nil, false
+	}
+	if index < l.len/2 {
+		e := l.root.next
+		for ; index > 0; index-- {
+			e = e.next
+		}
+		return e, true
+	}
+	e := l.root.prev
+	for i := l.len - 1; i > index; i-- {
+		e = e.prev
+	}
+	return e, true
+}
+
+// IndexOf returns the index of the first element of list l whose value equals value
+// according to eq, or -1 if there is no such element.
+func (l *List[T]) IndexOf(value T, eq func(a, b T) bool) int {
+	i := 0
+	for e := l.Front(); e != nil; e = e.Next() {
+		if eq(e.Value, value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Contains returns whether list l has an element whose value equals value according to eq.
+func (l *List[T]) Contains(value T, eq func(a, b T) bool) bool {
+	return l.IndexOf(value, eq) >= 0
+}
+
 // lazyInit lazily initializes a zero List value.
 func (l *List[T]) lazyInit() {
 	if l.root.next == nil {
@@ -136,6 +198,29 @@ func (l *List[T]) Delete(e *Element[T]) T {
 	return e.Value
 }
 
+// RemoveRange removes all elements from from to to inclusive and returns
+// the number of removed elements. If from or to is not an element of l,
+// or to is before from, the list is not modified.
+// The elements must not be nil.
+func (l *List[T]) RemoveRange(from, to *Element[T]) int {
+	if from.list != l || to.list != l {
+		return 0
+	}
+	n := 1
+	for e := from; e != to; e = e.next {
+		if e.next == &l.root {
+			return 0
+		}
+		n++
+	}
+	for e, i := from, 0; i < n; i++ {
+		next := e.next
+		l.remove(e)
+		e = next
+	}
+	return n
+}
+
 // PushFront inserts a new element e with value v at the front of list l and returns e.
 func (l *List[T]) PushFront(v T) *Element[T] {
 	l.lazyInit()
@@ -212,6 +297,40 @@ func (l *List[T]) MoveAfter(e, mark *Element[T]) {
 	l.move(e, mark)
 }
 
+// Swap exchanges the positions of elements a and b in list l.
+// If a or b is not an element of l, or a == b, the list is not modified.
+// The elements must not be nil.
+func (l *List[T]) Swap(a, b *Element[T]) {
+	if a.list != l || b.list != l || a == b {
+		return
+	}
+	switch {
+	case a.next == b:
+		l.move(a, b)
+	case b.next == a:
+		l.move(b, a)
+	default:
+		prev := a.prev
+		l.move(a, b)
+		l.move(b, prev)
+	}
+}
+
+// Reverse reverses the order of elements of list l in place.
+func (l *List[T]) Reverse() {
+	if l.len < 2 {
+		return
+	}
+	e := &l.root
+	for {
+		e.next, e.prev = e.prev, e.next
+		e = e.prev
+		if e == &l.root {
+			return
+		}
+	}
+}
+
 // PushBackList inserts a copy of another list at the back of list l.
 // The lists l and other may be the same. They must not be nil.
 func (l *List[T]) PushBackList(other *List[T]) {
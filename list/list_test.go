@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/bongnv/go-container/list"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestList(t *testing.T) {
@@ -33,3 +34,308 @@ func expectList(t *testing.T, l *list.List[string], elements ...string) {
 		}
 	}
 }
+
+func TestList_MoveToFrontBack(t *testing.T) {
+	newList := func() (*list.List[string], []*list.Element[string]) {
+		l := list.New[string]()
+		return l, []*list.Element[string]{l.PushBack("a"), l.PushBack("b"), l.PushBack("c")}
+	}
+
+	t.Run("should move elements to front", func(t *testing.T) {
+		l, elements := newList()
+		l.MoveToFront(elements[0])
+		expectList(t, l, "a", "b", "c")
+		l.MoveToFront(elements[1])
+		expectList(t, l, "b", "a", "c")
+		l.MoveToFront(elements[2])
+		expectList(t, l, "c", "b", "a")
+	})
+
+	t.Run("should move elements to back", func(t *testing.T) {
+		l, elements := newList()
+		l.MoveToBack(elements[2])
+		expectList(t, l, "a", "b", "c")
+		l.MoveToBack(elements[1])
+		expectList(t, l, "a", "c", "b")
+		l.MoveToBack(elements[0])
+		expectList(t, l, "c", "b", "a")
+	})
+
+	t.Run("should ignore elements of other lists", func(t *testing.T) {
+		l, _ := newList()
+		other := list.New[string]()
+		e := other.PushBack("d")
+		l.MoveToFront(e)
+		l.MoveToBack(e)
+		expectList(t, l, "a", "b", "c")
+		expectList(t, other, "d")
+	})
+}
+
+func TestList_InsertBeforeAfter(t *testing.T) {
+	t.Run("should insert relative to front, middle and back", func(t *testing.T) {
+		l := list.New[string]()
+		front := l.PushBack("b")
+		middle := l.PushBack("d")
+		back := l.PushBack("f")
+
+		if e := l.InsertBefore("a", front); e == nil || e.Value != "a" {
+			t.Errorf("expecting the inserted element")
+		}
+		l.InsertAfter("c", front)
+		l.InsertBefore("c2", middle)
+		l.InsertAfter("e", middle)
+		l.InsertAfter("g", back)
+		expectList(t, l, "a", "b", "c", "c2", "d", "e", "f", "g")
+		if l.Back().Value != "g" || l.Front().Value != "a" {
+			t.Errorf("unexpected front or back")
+		}
+	})
+
+	t.Run("should ignore marks of other lists", func(t *testing.T) {
+		l := list.New[string]()
+		l.PushBack("a")
+		mark := list.New[string]().PushBack("b")
+		if l.InsertBefore("c", mark) != nil || l.InsertAfter("c", mark) != nil {
+			t.Errorf("not expecting an inserted element")
+		}
+		expectList(t, l, "a")
+	})
+}
+
+func TestList_Clear(t *testing.T) {
+	l := list.New[string]()
+	l.PushBack("a")
+	l.PushBack("b")
+	l.Clear()
+	if l.Len() != 0 || l.Front() != nil || l.Back() != nil {
+		t.Errorf("expecting an empty list")
+	}
+
+	l.PushBack("c")
+	l.PushFront("d")
+	expectList(t, l, "d", "c")
+}
+
+func TestList_Values(t *testing.T) {
+	t.Run("should round-trip a slice", func(t *testing.T) {
+		items := []string{"a", "b", "c"}
+		l := list.NewFromSlice(items)
+		expectList(t, l, items...)
+		if diff := cmp.Diff(l.Values(), items); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("should handle an empty slice", func(t *testing.T) {
+		l := list.NewFromSlice[string](nil)
+		if l.Len() != 0 || len(l.Values()) != 0 {
+			t.Errorf("expecting an empty list")
+		}
+		l.PushBack("a")
+		expectList(t, l, "a")
+	})
+}
+
+func TestList_RemoveRange(t *testing.T) {
+	newList := func() (*list.List[string], []*list.Element[string]) {
+		l := list.New[string]()
+		var elements []*list.Element[string]
+		for _, v := range []string{"a", "b", "c", "d", "e"} {
+			elements = append(elements, l.PushBack(v))
+		}
+		return l, elements
+	}
+
+	t.Run("should remove a sub-span", func(t *testing.T) {
+		l, elements := newList()
+		if n := l.RemoveRange(elements[1], elements[3]); n != 3 {
+			t.Errorf("expected 3 removed elements but got %v", n)
+		}
+		expectList(t, l, "a", "e")
+		if elements[2].Next() != nil || elements[2].Prev() != nil {
+			t.Errorf("expected removed elements to be unlinked")
+		}
+	})
+
+	t.Run("should remove a single element", func(t *testing.T) {
+		l, elements := newList()
+		if n := l.RemoveRange(elements[2], elements[2]); n != 1 {
+			t.Errorf("expected 1 removed element but got %v", n)
+		}
+		expectList(t, l, "a", "b", "d", "e")
+	})
+
+	t.Run("should remove the whole list", func(t *testing.T) {
+		l, _ := newList()
+		if n := l.RemoveRange(l.Front(), l.Back()); n != 5 {
+			t.Errorf("expected 5 removed elements but got %v", n)
+		}
+		expectList(t, l)
+		if l.Front() != nil || l.Back() != nil {
+			t.Errorf("expected an empty list")
+		}
+	})
+
+	t.Run("should not modify the list if to is before from", func(t *testing.T) {
+		l, elements := newList()
+		if n := l.RemoveRange(elements[3], elements[1]); n != 0 {
+			t.Errorf("expected no removed elements but got %v", n)
+		}
+		expectList(t, l, "a", "b", "c", "d", "e")
+	})
+
+	t.Run("should not modify the list for elements of other lists", func(t *testing.T) {
+		l, elements := newList()
+		other := list.New[string]().PushBack("f")
+		if n := l.RemoveRange(elements[0], other); n != 0 {
+			t.Errorf("expected no removed elements but got %v", n)
+		}
+		expectList(t, l, "a", "b", "c", "d", "e")
+	})
+}
+
+func TestList_PushList(t *testing.T) {
+	t.Run("should append and prepend copies of another list", func(t *testing.T) {
+		l := list.NewFromSlice([]string{"c", "d"})
+		other := list.NewFromSlice([]string{"a", "b"})
+		l.PushFrontList(other)
+		l.PushBackList(other)
+		expectList(t, l, "a", "b", "c", "d", "a", "b")
+		expectList(t, other, "a", "b")
+
+		l.Front().Value = "z"
+		expectList(t, other, "a", "b")
+	})
+
+	t.Run("should splice a list with itself", func(t *testing.T) {
+		l := list.NewFromSlice([]string{"a", "b"})
+		l.PushBackList(l)
+		expectList(t, l, "a", "b", "a", "b")
+		l.PushFrontList(l)
+		expectList(t, l, "a", "b", "a", "b", "a", "b", "a", "b")
+	})
+
+	t.Run("should splice into a zero list", func(t *testing.T) {
+		var l list.List[string]
+		l.PushBackList(list.NewFromSlice([]string{"a"}))
+		l.PushFrontList(list.NewFromSlice([]string{"b"}))
+		expectList(t, &l, "b", "a")
+	})
+}
+
+func TestList_At(t *testing.T) {
+	l := list.NewFromSlice([]string{"a", "b", "c", "d", "e"})
+	for i, v := range []string{"a", "b", "c", "d", "e"} {
+		e, ok := l.At(i)
+		if !ok || e.Value != v {
+			t.Errorf("expected %v at %v", v, i)
+		}
+	}
+
+	for _, index := range []int{-1, 5} {
+		if e, ok := l.At(index); ok || e != nil {
+			t.Errorf("expected no element at %v", index)
+		}
+	}
+
+	if _, ok := list.New[string]().At(0); ok {
+		t.Errorf("expected no element in an empty list")
+	}
+}
+
+func TestList_Swap(t *testing.T) {
+	newList := func() (*list.List[string], []*list.Element[string]) {
+		l := list.New[string]()
+		var elements []*list.Element[string]
+		for _, v := range []string{"a", "b", "c", "d"} {
+			elements = append(elements, l.PushBack(v))
+		}
+		return l, elements
+	}
+
+	testCases := map[string]struct {
+		a, b     int
+		expected []string
+	}{
+		"adjacent elements":          {a: 1, b: 2, expected: []string{"a", "c", "b", "d"}},
+		"adjacent elements reversed": {a: 2, b: 1, expected: []string{"a", "c", "b", "d"}},
+		"non-adjacent elements":      {a: 0, b: 2, expected: []string{"c", "b", "a", "d"}},
+		"front and back":             {a: 3, b: 0, expected: []string{"d", "b", "c", "a"}},
+		"same element":               {a: 1, b: 1, expected: []string{"a", "b", "c", "d"}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			l, elements := newList()
+			l.Swap(elements[tc.a], elements[tc.b])
+			expectList(t, l, tc.expected...)
+			var backward []string
+			for e := l.Back(); e != nil; e = e.Prev() {
+				backward = append([]string{e.Value}, backward...)
+			}
+			if diff := cmp.Diff(backward, tc.expected); diff != "" {
+				t.Errorf("unexpected backward order (+got, -wanted): %v", diff)
+			}
+		})
+	}
+
+	t.Run("should ignore elements of other lists", func(t *testing.T) {
+		l, elements := newList()
+		l.Swap(elements[0], list.New[string]().PushBack("e"))
+		expectList(t, l, "a", "b", "c", "d")
+	})
+}
+
+func TestList_Reverse(t *testing.T) {
+	testCases := map[string]struct {
+		values   []string
+		expected []string
+	}{
+		"empty list":       {},
+		"single element":   {values: []string{"a"}, expected: []string{"a"}},
+		"even-length list": {values: []string{"a", "b", "c", "d"}, expected: []string{"d", "c", "b", "a"}},
+		"odd-length list":  {values: []string{"a", "b", "c"}, expected: []string{"c", "b", "a"}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			l := list.NewFromSlice(tc.values)
+			l.Reverse()
+			expectList(t, l, tc.expected...)
+			if len(tc.expected) > 0 && (l.Front().Value != tc.expected[0] || l.Back().Value != tc.expected[len(tc.expected)-1]) {
+				t.Errorf("unexpected front or back")
+			}
+			var backward []string
+			for e := l.Back(); e != nil; e = e.Prev() {
+				backward = append(backward, e.Value)
+			}
+			if diff := cmp.Diff(backward, tc.values); diff != "" {
+				t.Errorf("unexpected backward order (+got, -wanted): %v", diff)
+			}
+		})
+	}
+}
+
+func TestList_IndexOf(t *testing.T) {
+	eq := func(a, b string) bool { return a == b }
+	l := list.NewFromSlice([]string{"a", "b", "c", "b"})
+
+	if i := l.IndexOf("b", eq); i != 1 {
+		t.Errorf("expected 1 but got %v", i)
+	}
+	if !l.Contains("c", eq) {
+		t.Errorf("expected c to be present")
+	}
+	if i := l.IndexOf("d", eq); i != -1 {
+		t.Errorf("expected -1 but got %v", i)
+	}
+	if l.Contains("d", eq) {
+		t.Errorf("expected d to be absent")
+	}
+	if list.New[string]().Contains("a", eq) {
+		t.Errorf("expected a to be absent in an empty list")
+	}
+}
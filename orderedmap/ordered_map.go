@@ -6,6 +6,7 @@ import (
 	"cmp"
 	"errors"
 
+	"github.com/bongnv/go-container/algorithm"
 	"github.com/bongnv/go-container/list"
 )
 
@@ -26,6 +27,14 @@ func New[K cmp.Ordered, V any]() *OrderedMap[K, V] {
 	}
 }
 
+// NewWithCapacity creates a new ordered map with enough space for n keys.
+func NewWithCapacity[K cmp.Ordered, V any](n int) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		nodeOf: make(map[K]*list.Element[Pair[K, V]], n),
+		values: list.New[Pair[K, V]](),
+	}
+}
+
 // OrderedMap is an implementation of ordered map. It should be initialized with New function.
 type OrderedMap[K cmp.Ordered, V any] struct {
 	values *list.List[Pair[K, V]]
@@ -42,24 +51,43 @@ func (om *OrderedMap[K, V]) Get(key K) (value V, found bool) {
 	return node.Value.Value, true
 }
 
+// GetPair returns the stored pair of key and value for the provided key
+// and whether the key presents in the map or not.
+func (om *OrderedMap[K, V]) GetPair(key K) (pair Pair[K, V], found bool) {
+	node, found := om.nodeOf[key]
+	if !found {
+		return
+	}
+
+	return node.Value, true
+}
+
 // Set inserts a new key, value into the map or replaces it if the key presents in the map.
 func (om *OrderedMap[K, V]) Set(key K, value V) (oldVal V, replaced bool) {
+	oldVal, replaced, _ = om.SetChanged(key, value)
+	return oldVal, replaced
+}
+
+// SetChanged is the same as Set but it also reports whether the stored order changed.
+// The order changes when a new key is appended or an existing key is moved to the back.
+func (om *OrderedMap[K, V]) SetChanged(key K, value V) (oldVal V, replaced bool, orderChanged bool) {
 	node, found := om.nodeOf[key]
 	if !found {
 		om.nodeOf[key] = om.values.PushBack(Pair[K, V]{
 			Key:   key,
 			Value: value,
 		})
-		return
+		return oldVal, false, true
 	}
 
 	oldVal = node.Value.Value
+	orderChanged = om.values.Back() != node
 	om.values.Delete(node)
 	om.nodeOf[key] = om.values.PushBack(Pair[K, V]{
 		Key:   key,
 		Value: value,
 	})
-	return oldVal, true
+	return oldVal, true, orderChanged
 }
 
 // Len returns the size of the map.
@@ -82,54 +110,94 @@ func (om *OrderedMap[K, V]) Delete(key K) (val V, present bool) {
 
 // MoveAfter moves key to a new position after markedKey.
 func (om *OrderedMap[K, V]) MoveAfter(key, markedKey K) error {
+	_, err := om.MoveAfterChanged(key, markedKey)
+	return err
+}
+
+// MoveAfterChanged is the same as MoveAfter but it also reports whether the stored order changed.
+// The order doesn't change when key is already right after markedKey or key equals markedKey.
+func (om *OrderedMap[K, V]) MoveAfterChanged(key, markedKey K) (changed bool, err error) {
 	node, found := om.nodeOf[key]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 	markedNode, found := om.nodeOf[markedKey]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 
+	if node == markedNode || markedNode.Next() == node {
+		return false, nil
+	}
 	om.values.MoveAfter(node, markedNode)
-	return nil
+	return true, nil
 }
 
-// MoveAfter moves key to a new position after markedKey.
+// MoveBefore moves key to a new position before markedKey.
 func (om *OrderedMap[K, V]) MoveBefore(key, markedKey K) error {
+	_, err := om.MoveBeforeChanged(key, markedKey)
+	return err
+}
+
+// MoveBeforeChanged is the same as MoveBefore but it also reports whether the stored order changed.
+// The order doesn't change when key is already right before markedKey or key equals markedKey.
+func (om *OrderedMap[K, V]) MoveBeforeChanged(key, markedKey K) (changed bool, err error) {
 	node, found := om.nodeOf[key]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 	markedNode, found := om.nodeOf[markedKey]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 
+	if node == markedNode || markedNode.Prev() == node {
+		return false, nil
+	}
 	om.values.MoveBefore(node, markedNode)
-	return nil
+	return true, nil
 }
 
 // MoveToFront moves key to the front of list.
 func (om *OrderedMap[K, V]) MoveToFront(key K) error {
+	_, err := om.MoveToFrontChanged(key)
+	return err
+}
+
+// MoveToFrontChanged is the same as MoveToFront but it also reports whether the stored order changed.
+// The order doesn't change when key is already at the front.
+func (om *OrderedMap[K, V]) MoveToFrontChanged(key K) (changed bool, err error) {
 	node, found := om.nodeOf[key]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 
+	if om.values.Front() == node {
+		return false, nil
+	}
 	om.values.MoveToFront(node)
-	return nil
+	return true, nil
 }
 
-// MoveToBack moves key to the front of list.
+// MoveToBack moves key to the back of list.
 func (om *OrderedMap[K, V]) MoveToBack(key K) error {
+	_, err := om.MoveToBackChanged(key)
+	return err
+}
+
+// MoveToBackChanged is the same as MoveToBack but it also reports whether the stored order changed.
+// The order doesn't change when key is already at the back.
+func (om *OrderedMap[K, V]) MoveToBackChanged(key K) (changed bool, err error) {
 	node, found := om.nodeOf[key]
 	if !found {
-		return ErrKeyNotFound
+		return false, ErrKeyNotFound
 	}
 
+	if om.values.Back() == node {
+		return false, nil
+	}
 	om.values.MoveToBack(node)
-	return nil
+	return true, nil
 }
 
 // Front returns the pair of key and value at the front of the list.
@@ -161,3 +229,33 @@ func (om *OrderedMap[K, V]) ReverseScan(itor func(key K, val V) bool) {
 		}
 	}
 }
+
+// SortByKey reorders the map so it's scanned in the order of keys according to less.
+// Keys which are equal according to less keep their relative order.
+func (om *OrderedMap[K, V]) SortByKey(less func(a, b K) bool) {
+	om.sort(func(a, b Pair[K, V]) bool {
+		return less(a.Key, b.Key)
+	})
+}
+
+// SortByValue reorders the map so it's scanned in the order of values according to less.
+// Keys with equal values according to less keep their relative order.
+func (om *OrderedMap[K, V]) SortByValue(less func(a, b V) bool) {
+	om.sort(func(a, b Pair[K, V]) bool {
+		return less(a.Value, b.Value)
+	})
+}
+
+func (om *OrderedMap[K, V]) sort(less algorithm.LessFunc[Pair[K, V]]) {
+	nodes := make([]*list.Element[Pair[K, V]], 0, om.Len())
+	for node := om.values.Front(); node != nil; node = node.Next() {
+		nodes = append(nodes, node)
+	}
+	algorithm.SortStableFunc(nodes, func(a, b *list.Element[Pair[K, V]]) bool {
+		return less(a.Value, b.Value)
+	})
+	// nodes are moved instead of recreated, so nodeOf stays valid.
+	for _, node := range nodes {
+		om.values.MoveToBack(node)
+	}
+}
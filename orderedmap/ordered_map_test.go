@@ -201,3 +201,234 @@ func TestOrderedMap(t *testing.T) {
 		t.Errorf("Delete returns invalid values")
 	}
 }
+
+func TestOrderedMap_SetChanged(t *testing.T) {
+	testCases := map[string]struct {
+		scenario             func(om *orderedmap.OrderedMap[int, string])
+		key                  int
+		value                string
+		expectedOldVal       string
+		expectedReplaced     bool
+		expectedOrderChanged bool
+	}{
+		"should report a change when a new key is appended": {
+			scenario: func(om *orderedmap.OrderedMap[int, string]) {
+				om.Set(1, "one")
+			},
+			key:                  2,
+			value:                "two",
+			expectedOrderChanged: true,
+		},
+		"should report no change when the key is already at the back": {
+			scenario: func(om *orderedmap.OrderedMap[int, string]) {
+				om.Set(1, "one")
+				om.Set(2, "two")
+			},
+			key:              2,
+			value:            "new-two",
+			expectedOldVal:   "two",
+			expectedReplaced: true,
+		},
+		"should report a change when an existing key is moved to the back": {
+			scenario: func(om *orderedmap.OrderedMap[int, string]) {
+				om.Set(1, "one")
+				om.Set(2, "two")
+			},
+			key:                  1,
+			value:                "new-one",
+			expectedOldVal:       "one",
+			expectedReplaced:     true,
+			expectedOrderChanged: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			om := orderedmap.New[int, string]()
+			tc.scenario(om)
+			oldVal, replaced, orderChanged := om.SetChanged(tc.key, tc.value)
+			if oldVal != tc.expectedOldVal || replaced != tc.expectedReplaced || orderChanged != tc.expectedOrderChanged {
+				t.Errorf("SetChanged returns invalid values, oldVal: %v, replaced: %v, orderChanged: %v", oldVal, replaced, orderChanged)
+			}
+
+			backKey, backVal := om.Back()
+			if backKey != tc.key || backVal != tc.value {
+				t.Errorf("invalid back values")
+			}
+		})
+	}
+}
+
+func TestOrderedMap_GetPair(t *testing.T) {
+	om := orderedmap.New[int, string]()
+	om.Set(1, "one")
+	om.Set(2, "two")
+
+	pair, found := om.GetPair(2)
+	if diff := cmp.Diff(pair, orderedmap.Pair[int, string]{Key: 2, Value: "two"}); diff != "" || !found {
+		t.Errorf("GetPair returns invalid values (+got, -wanted): %v, found: %v", diff, found)
+	}
+
+	pair, found = om.GetPair(3)
+	if diff := cmp.Diff(pair, orderedmap.Pair[int, string]{}); diff != "" || found {
+		t.Errorf("GetPair returns invalid values (+got, -wanted): %v, found: %v", diff, found)
+	}
+}
+
+func TestNewWithCapacity(t *testing.T) {
+	om := orderedmap.NewWithCapacity[int, string](10)
+	for i := 0; i < 20; i++ {
+		om.Set(i, "value")
+	}
+	om.Delete(0)
+	if err := om.MoveToFront(19); err != nil {
+		t.Errorf("MoveToFront returns an error: %v", err)
+	}
+	if om.Len() != 19 {
+		t.Errorf("expecting len 19, got %d", om.Len())
+	}
+
+	var keys []int
+	om.Scan(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return true
+	})
+	expected := []int{19}
+	for i := 1; i < 19; i++ {
+		expected = append(expected, i)
+	}
+	if diff := cmp.Diff(keys, expected); diff != "" {
+		t.Errorf("unexpected keys (+got, -wanted): %v", diff)
+	}
+}
+
+func TestOrderedMap_Sort(t *testing.T) {
+	newMap := func() *orderedmap.OrderedMap[int, string] {
+		om := orderedmap.New[int, string]()
+		om.Set(3, "b")
+		om.Set(1, "c")
+		om.Set(4, "a")
+		om.Set(2, "b")
+		return om
+	}
+	scan := func(om *orderedmap.OrderedMap[int, string]) []orderedmap.Pair[int, string] {
+		var pairs []orderedmap.Pair[int, string]
+		om.Scan(func(key int, val string) bool {
+			pairs = append(pairs, orderedmap.Pair[int, string]{Key: key, Value: val})
+			return true
+		})
+		return pairs
+	}
+
+	t.Run("should sort by key", func(t *testing.T) {
+		om := newMap()
+		om.SortByKey(func(a, b int) bool { return a < b })
+		expected := []orderedmap.Pair[int, string]{{1, "c"}, {2, "b"}, {3, "b"}, {4, "a"}}
+		if diff := cmp.Diff(scan(om), expected); diff != "" {
+			t.Errorf("unexpected order (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("should sort by value stably", func(t *testing.T) {
+		om := newMap()
+		om.SortByValue(func(a, b string) bool { return a < b })
+		expected := []orderedmap.Pair[int, string]{{4, "a"}, {3, "b"}, {2, "b"}, {1, "c"}}
+		if diff := cmp.Diff(scan(om), expected); diff != "" {
+			t.Errorf("unexpected order (+got, -wanted): %v", diff)
+		}
+
+		if val, found := om.Get(2); !found || val != "b" {
+			t.Errorf("Get returns invalid values after sorting")
+		}
+		if err := om.MoveToFront(1); err != nil {
+			t.Errorf("MoveToFront returns an error: %v", err)
+		}
+		if val, present := om.Delete(3); !present || val != "b" {
+			t.Errorf("Delete returns invalid values after sorting")
+		}
+		expected = []orderedmap.Pair[int, string]{{1, "c"}, {4, "a"}, {2, "b"}}
+		if diff := cmp.Diff(scan(om), expected); diff != "" {
+			t.Errorf("unexpected order (+got, -wanted): %v", diff)
+		}
+	})
+}
+
+func TestOrderedMap_MoveChanged(t *testing.T) {
+	testCases := map[string]struct {
+		move            func(om *orderedmap.OrderedMap[int, string]) (bool, error)
+		expectedChanged bool
+		expectedErr     error
+		expectedKeys    []int
+	}{
+		"MoveToFrontChanged should report no change at the front": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveToFrontChanged(1) },
+			expectedKeys: []int{1, 2, 3},
+		},
+		"MoveToFrontChanged should report a change": {
+			move:            func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveToFrontChanged(2) },
+			expectedChanged: true,
+			expectedKeys:    []int{2, 1, 3},
+		},
+		"MoveToBackChanged should report no change at the back": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveToBackChanged(3) },
+			expectedKeys: []int{1, 2, 3},
+		},
+		"MoveToBackChanged should report a change": {
+			move:            func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveToBackChanged(1) },
+			expectedChanged: true,
+			expectedKeys:    []int{2, 3, 1},
+		},
+		"MoveAfterChanged should report no change if the key is right after the mark": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveAfterChanged(2, 1) },
+			expectedKeys: []int{1, 2, 3},
+		},
+		"MoveAfterChanged should report no change if the key is the mark": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveAfterChanged(2, 2) },
+			expectedKeys: []int{1, 2, 3},
+		},
+		"MoveAfterChanged should report a change": {
+			move:            func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveAfterChanged(1, 2) },
+			expectedChanged: true,
+			expectedKeys:    []int{2, 1, 3},
+		},
+		"MoveBeforeChanged should report no change if the key is right before the mark": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveBeforeChanged(2, 3) },
+			expectedKeys: []int{1, 2, 3},
+		},
+		"MoveBeforeChanged should report a change": {
+			move:            func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveBeforeChanged(3, 1) },
+			expectedChanged: true,
+			expectedKeys:    []int{3, 1, 2},
+		},
+		"MoveBeforeChanged should return an error if the key isn't found": {
+			move:         func(om *orderedmap.OrderedMap[int, string]) (bool, error) { return om.MoveBeforeChanged(4, 1) },
+			expectedErr:  orderedmap.ErrKeyNotFound,
+			expectedKeys: []int{1, 2, 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			om := orderedmap.New[int, string]()
+			om.Set(1, "one")
+			om.Set(2, "two")
+			om.Set(3, "three")
+
+			changed, err := tc.move(om)
+			if changed != tc.expectedChanged || err != tc.expectedErr {
+				t.Errorf("unexpected result, changed: %v, err: %v", changed, err)
+			}
+
+			var keys []int
+			om.Scan(func(key int, _ string) bool {
+				keys = append(keys, key)
+				return true
+			})
+			if diff := cmp.Diff(keys, tc.expectedKeys); diff != "" {
+				t.Errorf("unexpected order (+got, -wanted): %v", diff)
+			}
+		})
+	}
+}
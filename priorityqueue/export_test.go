@@ -0,0 +1,9 @@
+package priorityqueue
+
+import "math/rand"
+
+// Scramble shuffles the values of h, which likely violates the heap property.
+func Scramble[T any](h *PriorityQueue[T], r *rand.Rand) {
+	hc := &h.container
+	r.Shuffle(len(hc.nodes), hc.Swap)
+}
@@ -0,0 +1,62 @@
+package priorityqueue
+
+import (
+	"cmp"
+)
+
+// KVPriorityQueue represents a priority queue of values ordered by separate priorities.
+// Values with the same priority are popped in the order they are pushed.
+type KVPriorityQueue[T any, P cmp.Ordered] struct {
+	queue *PriorityQueue[kvPair[T, P]]
+	seq   uint64
+}
+
+type kvPair[T any, P cmp.Ordered] struct {
+	value    T
+	priority P
+	seq      uint64
+}
+
+// NewKV creates a new priority queue of T ordered by priorities of P.
+func NewKV[T any, P cmp.Ordered]() *KVPriorityQueue[T, P] {
+	return &KVPriorityQueue[T, P]{
+		queue: NewFunc[kvPair[T, P]](func(x, y kvPair[T, P]) bool {
+			if x.priority != y.priority {
+				return cmp.Less(x.priority, y.priority)
+			}
+			return x.seq < y.seq
+		}),
+	}
+}
+
+// Push pushes a value with its priority into the queue.
+func (q *KVPriorityQueue[T, P]) Push(value T, priority P) {
+	q.queue.Push(kvPair[T, P]{
+		value:    value,
+		priority: priority,
+		seq:      q.seq,
+	})
+	q.seq++
+}
+
+// Pop pops a value and its priority from the queue.
+func (q *KVPriorityQueue[T, P]) Pop() (T, P) {
+	pair := q.queue.Pop()
+	return pair.value, pair.priority
+}
+
+// Top returns the value and its priority at the top of the queue.
+func (q *KVPriorityQueue[T, P]) Top() (T, P) {
+	pair := q.queue.Top()
+	return pair.value, pair.priority
+}
+
+// Len returns the size of the queue.
+func (q *KVPriorityQueue[T, P]) Len() int {
+	return q.queue.Len()
+}
+
+// Empty returns whether the queue is empty or not.
+func (q *KVPriorityQueue[T, P]) Empty() bool {
+	return q.queue.Empty()
+}
@@ -0,0 +1,52 @@
+package priorityqueue_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/priorityqueue"
+	gocmp "github.com/google/go-cmp/cmp"
+)
+
+func TestKVPriorityQueue(t *testing.T) {
+	t.Run("should pop values in the order of priorities", func(t *testing.T) {
+		q := priorityqueue.NewKV[string, int]()
+		q.Push("three", 3)
+		q.Push("one", 1)
+		q.Push("two", 2)
+
+		if v, p := q.Top(); v != "one" || p != 1 {
+			t.Fatalf("expected one, 1 but got %v, %v", v, p)
+		}
+
+		values := make([]string, 0, q.Len())
+		priorities := make([]int, 0, q.Len())
+		for !q.Empty() {
+			v, p := q.Pop()
+			values = append(values, v)
+			priorities = append(priorities, p)
+		}
+		if diff := gocmp.Diff(values, []string{"one", "two", "three"}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+		if diff := gocmp.Diff(priorities, []int{1, 2, 3}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should pop values with the same priority in the pushed order", func(t *testing.T) {
+		q := priorityqueue.NewKV[string, int]()
+		q.Push("a", 1)
+		q.Push("b", 0)
+		q.Push("c", 1)
+		q.Push("d", 1)
+
+		values := make([]string, 0, q.Len())
+		for !q.Empty() {
+			v, _ := q.Pop()
+			values = append(values, v)
+		}
+		if diff := gocmp.Diff(values, []string{"b", "a", "c", "d"}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+}
@@ -8,9 +8,18 @@ import (
 	"github.com/bongnv/go-container/algorithm"
 )
 
+// Item is a handle of a value pushed into the queue with PushWithHandle.
+type Item[T any] struct {
+	Value T
+	index int
+}
+
 // PriorityQueue represents a priority queue.
 type PriorityQueue[T any] struct {
 	container heapContainer[T]
+	// limit is the maximum number of values kept in the queue.
+	// Zero means the queue is unbounded.
+	limit int
 }
 
 // New creates a new priority queue of T.
@@ -27,11 +36,87 @@ func NewFunc[T any](less algorithm.LessFunc[T]) *PriorityQueue[T] {
 	}
 }
 
+// NewBounded creates a new priority queue of T using less which keeps at most k values.
+// When the queue is full, a pushed value displaces the value at the top of the queue
+// if it's greater than that value, otherwise it's dropped. Therefore the queue keeps
+// the k greatest values and Top returns the least of them. The k must be positive.
+func NewBounded[T any](k int, less algorithm.LessFunc[T]) *PriorityQueue[T] {
+	if k <= 0 {
+		panic("priorityqueue: k must be positive")
+	}
+	h := NewFunc[T](less)
+	h.limit = k
+	return h
+}
+
+// NewFromSlice creates a new priority queue of T from items in O(n).
+// The queue takes ownership of items, so it must not be modified afterward.
+func NewFromSlice[T cmp.Ordered](items []T) *PriorityQueue[T] {
+	return NewFuncFromSlice[T](items, cmp.Less[T])
+}
+
+// NewFuncFromSlice creates a new priority queue of T from items using less in O(n).
+// The queue takes ownership of items, so it must not be modified afterward.
+func NewFuncFromSlice[T any](items []T, less algorithm.LessFunc[T]) *PriorityQueue[T] {
+	h := NewFunc[T](less)
+	h.container.nodes = items
+	heap.Init(&h.container)
+	return h
+}
+
 // Push pushes a value into the queue.
 func (h *PriorityQueue[T]) Push(value T) {
+	if !h.makeRoom(value) {
+		return
+	}
 	heap.Push(&h.container, value)
 }
 
+// PushWithHandle pushes a value into the queue.
+// It returns the created item for the provided value which can be used
+// to update the value later. If the value is dropped by a bounded queue,
+// the returned item isn't in the queue.
+func (h *PriorityQueue[T]) PushWithHandle(value T) *Item[T] {
+	if !h.makeRoom(value) {
+		return &Item[T]{
+			Value: value,
+			index: -1,
+		}
+	}
+	hc := &h.container
+	if hc.items == nil {
+		hc.items = make([]*Item[T], len(hc.nodes), cap(hc.nodes))
+	}
+	item := &Item[T]{
+		Value: value,
+		index: len(hc.nodes),
+	}
+	hc.nodes = append(hc.nodes, value)
+	hc.items = append(hc.items, item)
+	heap.Fix(hc, item.index)
+	return item
+}
+
+// Update changes the value of item and fixes its position in the queue.
+// If item is no longer in the queue, only its value is changed.
+func (h *PriorityQueue[T]) Update(item *Item[T], value T) {
+	item.Value = value
+	if h.contains(item) {
+		h.container.nodes[item.index] = value
+		heap.Fix(&h.container, item.index)
+	}
+}
+
+// Remove removes item from the queue and returns its value.
+// If item is no longer in the queue, the queue is not modified.
+// The item must not be nil.
+func (h *PriorityQueue[T]) Remove(item *Item[T]) T {
+	if !h.contains(item) {
+		return item.Value
+	}
+	return heap.Remove(&h.container, item.index).(T)
+}
+
 // Pop pops a value from the queue.
 func (h *PriorityQueue[T]) Pop() T {
 	val := heap.Pop(&h.container).(T)
@@ -43,6 +128,23 @@ func (h *PriorityQueue[T]) Top() T {
 	return h.container.nodes[0]
 }
 
+// Peek returns the value at the top of the queue and whether the queue isn't empty.
+func (h *PriorityQueue[T]) Peek() (value T, ok bool) {
+	if h.Len() == 0 {
+		return
+	}
+	return h.container.nodes[0], true
+}
+
+// TryPop pops a value from the queue if the queue isn't empty.
+// It returns false if the queue is empty.
+func (h *PriorityQueue[T]) TryPop() (value T, ok bool) {
+	if h.Len() == 0 {
+		return
+	}
+	return h.Pop(), true
+}
+
 // Size returns the size of the queue.
 func (h *PriorityQueue[T]) Len() int {
 	return len(h.container.nodes)
@@ -53,8 +155,114 @@ func (s *PriorityQueue[T]) Empty() bool {
 	return s.Len() == 0
 }
 
+// Reheapify restores the heap property of the queue in O(n).
+// It's a safety valve for when the order of values may have been
+// violated externally, e.g. values are mutated in place.
+func (s *PriorityQueue[T]) Reheapify() {
+	heap.Init(&s.container)
+}
+
+// Merge moves all values of other into the queue in O(n), leaving other empty.
+// Items of other remain valid handles of the queue. If the queue is bounded,
+// the least values are dropped to keep its limit.
+// Both queues must be created with the same less function.
+func (s *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	if s == other {
+		return
+	}
+	hc, oc := &s.container, &other.container
+	if hc.items == nil && oc.items != nil {
+		hc.items = make([]*Item[T], len(hc.nodes), len(hc.nodes)+len(oc.nodes))
+	}
+	if hc.items != nil {
+		for i := range oc.nodes {
+			var item *Item[T]
+			if oc.items != nil {
+				item = oc.items[i]
+			}
+			if item != nil {
+				item.index = len(hc.items)
+			}
+			hc.items = append(hc.items, item)
+		}
+	}
+	hc.nodes = append(hc.nodes, oc.nodes...)
+	oc.nodes = nil
+	oc.items = nil
+	heap.Init(hc)
+	for s.limit > 0 && s.Len() > s.limit {
+		heap.Pop(hc)
+	}
+}
+
+// Drain pops all values from the queue and returns them in the popped order.
+func (s *PriorityQueue[T]) Drain() []T {
+	values := make([]T, 0, s.Len())
+	for !s.Empty() {
+		values = append(values, s.Pop())
+	}
+	return values
+}
+
+// DrainWhile pops values from the queue while pred returns true for the value
+// at the top of the queue, and calls f for each popped value in the popped order.
+// It stops as soon as pred returns false, leaving that value in the queue.
+func (s *PriorityQueue[T]) DrainWhile(pred func(T) bool, f func(T)) {
+	for !s.Empty() && pred(s.Top()) {
+		f(s.Pop())
+	}
+}
+
+// Clone returns an independent copy of the queue.
+// Items returned by PushWithHandle only refer to values of the original queue.
+func (s *PriorityQueue[T]) Clone() *PriorityQueue[T] {
+	s2 := NewFunc[T](s.container.less)
+	s2.limit = s.limit
+	s2.container.nodes = make([]T, len(s.container.nodes), cap(s.container.nodes))
+	copy(s2.container.nodes, s.container.nodes)
+	return s2
+}
+
+// Clear removes all values from the queue.
+// The capacity of the underlying array is retained for later pushes.
+func (s *PriorityQueue[T]) Clear() {
+	var empty T
+	for i := range s.container.nodes {
+		s.container.nodes[i] = empty // avoid memory leak
+	}
+	s.container.nodes = s.container.nodes[:0]
+	for i, item := range s.container.items {
+		if item != nil {
+			item.index = -1 // for safety
+		}
+		s.container.items[i] = nil // avoid memory leak
+	}
+	s.container.items = nil
+}
+
+// makeRoom pops the top of a full bounded queue if value should displace it.
+// It returns whether value should be pushed into the queue.
+func (h *PriorityQueue[T]) makeRoom(value T) bool {
+	if h.limit == 0 || h.Len() < h.limit {
+		return true
+	}
+	if !h.container.less(h.container.nodes[0], value) {
+		return false
+	}
+	heap.Pop(&h.container)
+	return true
+}
+
+// contains returns whether item is an item of the queue.
+func (h *PriorityQueue[T]) contains(item *Item[T]) bool {
+	return item.index >= 0 && item.index < len(h.container.items) && h.container.items[item.index] == item
+}
+
 type heapContainer[T any] struct {
 	nodes []T
+	// items holds the handles of nodes. It's nil until a value is pushed
+	// with PushWithHandle and it contains nil for values without handles.
+	items []*Item[T]
 	less  algorithm.LessFunc[T]
 }
 
@@ -68,15 +276,35 @@ func (hc heapContainer[T]) Less(i, j int) bool {
 
 func (hc heapContainer[T]) Swap(i, j int) {
 	hc.nodes[i], hc.nodes[j] = hc.nodes[j], hc.nodes[i]
+	if hc.items == nil {
+		return
+	}
+	hc.items[i], hc.items[j] = hc.items[j], hc.items[i]
+	if hc.items[i] != nil {
+		hc.items[i].index = i
+	}
+	if hc.items[j] != nil {
+		hc.items[j].index = j
+	}
 }
 
 func (hc *heapContainer[T]) Push(x any) {
 	hc.nodes = append(hc.nodes, x.(T))
+	if hc.items != nil {
+		hc.items = append(hc.items, nil)
+	}
 }
 
 func (hc *heapContainer[T]) Pop() any {
 	n := len(hc.nodes)
 	item := hc.nodes[n-1]
 	hc.nodes = hc.nodes[0 : n-1]
+	if hc.items != nil {
+		if handle := hc.items[n-1]; handle != nil {
+			handle.index = -1 // for safety
+		}
+		hc.items[n-1] = nil // avoid memory leak
+		hc.items = hc.items[0 : n-1]
+	}
 	return item
 }
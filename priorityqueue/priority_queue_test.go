@@ -2,9 +2,11 @@ package priorityqueue_test
 
 import (
 	"cmp"
+	"math/rand"
 	"testing"
 
 	"github.com/bongnv/go-container/priorityqueue"
+	gocmp "github.com/google/go-cmp/cmp"
 )
 
 func TestPriorityQueue(t *testing.T) {
@@ -104,3 +106,329 @@ func greater[T cmp.Ordered](x, y T) bool {
 type Custom struct {
 	Value int
 }
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	h := priorityqueue.New[int]()
+	if v, ok := h.Peek(); ok || v != 0 {
+		t.Fatalf("expected nothing but got %v, %v", v, ok)
+	}
+	if v, ok := h.TryPop(); ok || v != 0 {
+		t.Fatalf("expected nothing but got %v, %v", v, ok)
+	}
+
+	h.Push(2)
+	h.Push(1)
+	if v, ok := h.Peek(); !ok || v != 1 {
+		t.Fatalf("expected 1 but got %v, %v", v, ok)
+	}
+	if v, ok := h.TryPop(); !ok || v != 1 {
+		t.Fatalf("expected 1 but got %v, %v", v, ok)
+	}
+	if h.Len() != 1 {
+		t.Fatalf("expected 1 but got %v", h.Len())
+	}
+}
+
+func TestPriorityQueue_Clear(t *testing.T) {
+	h := priorityqueue.New[int]()
+	if !h.Empty() {
+		t.Fatalf("expected the queue to be empty")
+	}
+
+	h.Push(2)
+	h.Push(1)
+	h.Clear()
+	if !h.Empty() {
+		t.Fatalf("expected the queue to be empty")
+	}
+
+	h.Push(4)
+	h.Push(3)
+	if h.Empty() {
+		t.Fatalf("expected the queue not to be empty")
+	}
+	if v := h.Pop(); v != 3 {
+		t.Fatalf("expected 3 but got %v", v)
+	}
+	if h.Len() != 1 {
+		t.Fatalf("expected 1 but got %v", h.Len())
+	}
+}
+
+func TestPriorityQueue_Update(t *testing.T) {
+	testCases := map[string]struct {
+		scenario      func(h *priorityqueue.PriorityQueue[int])
+		expectedOrder []int
+	}{
+		"should move a value up after increasing its priority": {
+			scenario: func(h *priorityqueue.PriorityQueue[int]) {
+				h.Push(1)
+				h.Push(2)
+				five := h.PushWithHandle(5)
+				h.Push(3)
+				h.Update(five, 0)
+			},
+			expectedOrder: []int{0, 1, 2, 3},
+		},
+		"should move a value down after decreasing its priority": {
+			scenario: func(h *priorityqueue.PriorityQueue[int]) {
+				one := h.PushWithHandle(1)
+				h.Push(2)
+				h.PushWithHandle(5)
+				h.Push(3)
+				h.Update(one, 4)
+			},
+			expectedOrder: []int{2, 3, 4, 5},
+		},
+		"should ignore a popped handle": {
+			scenario: func(h *priorityqueue.PriorityQueue[int]) {
+				one := h.PushWithHandle(1)
+				h.Push(2)
+				h.Pop()
+				h.Update(one, 0)
+			},
+			expectedOrder: []int{2},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			h := priorityqueue.New[int]()
+			tc.scenario(h)
+			popped := make([]int, 0, h.Len())
+			for !h.Empty() {
+				popped = append(popped, h.Pop())
+			}
+			if diff := gocmp.Diff(popped, tc.expectedOrder); diff != "" {
+				t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_Remove(t *testing.T) {
+	h := priorityqueue.New[int]()
+	h.Push(1)
+	three := h.PushWithHandle(3)
+	h.Push(2)
+	h.Push(4)
+
+	if v := h.Remove(three); v != 3 {
+		t.Fatalf("expected 3 but got %v", v)
+	}
+	if v := h.Remove(three); v != 3 {
+		t.Fatalf("expected 3 but got %v", v)
+	}
+
+	popped := make([]int, 0, h.Len())
+	for !h.Empty() {
+		popped = append(popped, h.Pop())
+	}
+	if diff := gocmp.Diff(popped, []int{1, 2, 4}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestNewFromSlice(t *testing.T) {
+	h := priorityqueue.NewFromSlice([]int{5, 3, 4, 1, 2})
+	if h.Top() != 1 {
+		t.Fatalf("expected 1 but got %v", h.Top())
+	}
+
+	popped := make([]int, 0, h.Len())
+	for !h.Empty() {
+		popped = append(popped, h.Pop())
+	}
+	if diff := gocmp.Diff(popped, []int{1, 2, 3, 4, 5}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+
+	h = priorityqueue.NewFuncFromSlice([]int{1, 3, 2}, greater[int])
+	if h.Top() != 3 {
+		t.Fatalf("expected 3 but got %v", h.Top())
+	}
+}
+
+func BenchmarkNewFromSlice(b *testing.B) {
+	items := rand.Perm(10000)
+	values := make([]int, len(items))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, items)
+		priorityqueue.NewFromSlice(values)
+	}
+}
+
+func BenchmarkPush(b *testing.B) {
+	items := rand.Perm(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := priorityqueue.New[int]()
+		for _, item := range items {
+			h.Push(item)
+		}
+	}
+}
+
+func TestNewBounded(t *testing.T) {
+	h := priorityqueue.NewBounded[int](3, cmp.Less[int])
+	for _, v := range []int{5, 1, 9, 3, 7, 8, 2, 9} {
+		h.Push(v)
+	}
+	if h.Len() != 3 {
+		t.Fatalf("expected 3 but got %v", h.Len())
+	}
+
+	dropped := h.PushWithHandle(4)
+	h.Update(dropped, 10)
+	if h.Top() != 8 {
+		t.Fatalf("expected 8 but got %v", h.Top())
+	}
+
+	popped := make([]int, 0, h.Len())
+	for !h.Empty() {
+		popped = append(popped, h.Pop())
+	}
+	if diff := gocmp.Diff(popped, []int{8, 9, 9}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestPriorityQueue_Clone(t *testing.T) {
+	h := priorityqueue.New[int]()
+	h.Push(3)
+	h.Push(1)
+	h.Push(2)
+
+	clone := h.Clone()
+	if v := clone.Pop(); v != 1 {
+		t.Fatalf("expected 1 but got %v", v)
+	}
+	clone.Push(0)
+
+	popped := make([]int, 0, h.Len())
+	for !h.Empty() {
+		popped = append(popped, h.Pop())
+	}
+	if diff := gocmp.Diff(popped, []int{1, 2, 3}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+
+	popped = popped[:0]
+	for !clone.Empty() {
+		popped = append(popped, clone.Pop())
+	}
+	if diff := gocmp.Diff(popped, []int{0, 2, 3}); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+}
+
+func TestPriorityQueue_Drain(t *testing.T) {
+	h := priorityqueue.New[int]()
+	for _, v := range rand.Perm(100) {
+		h.Push(v)
+	}
+
+	values := h.Drain()
+	expected := make([]int, 100)
+	for i := range expected {
+		expected[i] = i
+	}
+	if diff := gocmp.Diff(values, expected); diff != "" {
+		t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+	}
+	if h.Len() != 0 {
+		t.Fatalf("expected 0 but got %v", h.Len())
+	}
+}
+
+func TestPriorityQueue_Merge(t *testing.T) {
+	t.Run("should merge values and handles", func(t *testing.T) {
+		h := priorityqueue.New[int]()
+		h.Push(4)
+		h.Push(1)
+		other := priorityqueue.New[int]()
+		three := other.PushWithHandle(3)
+		other.Push(2)
+
+		h.Merge(other)
+		if !other.Empty() {
+			t.Fatalf("expected the other queue to be empty")
+		}
+
+		h.Update(three, 0)
+		if diff := gocmp.Diff(h.Drain(), []int{0, 1, 2, 4}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+
+	t.Run("should keep the limit of a bounded queue", func(t *testing.T) {
+		h := priorityqueue.NewBounded[int](2, cmp.Less[int])
+		h.Push(1)
+		h.Push(4)
+		other := priorityqueue.New[int]()
+		other.Push(3)
+		other.Push(2)
+
+		h.Merge(other)
+		if diff := gocmp.Diff(h.Drain(), []int{3, 4}); diff != "" {
+			t.Errorf("Unexpected result, (+got|-wanted): %s", diff)
+		}
+	})
+}
+
+func TestPriorityQueue_Reheapify(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := priorityqueue.New[int]()
+	var items []*priorityqueue.Item[int]
+	for i := 0; i < 100; i++ {
+		if i%2 == 0 {
+			items = append(items, h.PushWithHandle(i))
+		} else {
+			h.Push(i)
+		}
+	}
+
+	priorityqueue.Scramble(h, r)
+	h.Reheapify()
+	for _, item := range items[:10] {
+		h.Remove(item)
+	}
+
+	// the first 10 even values are removed.
+	var expected []int
+	for i := 0; i < 100; i++ {
+		if i%2 == 1 || i >= 20 {
+			expected = append(expected, i)
+		}
+	}
+	if diff := gocmp.Diff(expected, h.Drain()); diff != "" {
+		t.Fatalf("unexpected order after Reheapify: %s", diff)
+	}
+}
+
+func TestPriorityQueue_DrainWhile(t *testing.T) {
+	h := priorityqueue.NewFromSlice([]int{5, 1, 4, 2, 3, 6})
+
+	var drained []int
+	h.DrainWhile(func(v int) bool {
+		return v <= 3
+	}, func(v int) {
+		drained = append(drained, v)
+	})
+	if diff := gocmp.Diff([]int{1, 2, 3}, drained); diff != "" {
+		t.Fatalf("unexpected drained values: %s", diff)
+	}
+	if diff := gocmp.Diff([]int{4, 5, 6}, h.Drain()); diff != "" {
+		t.Fatalf("unexpected remaining values: %s", diff)
+	}
+
+	h.DrainWhile(func(int) bool {
+		return true
+	}, func(v int) {
+		t.Fatalf("not expecting %v from an empty queue", v)
+	})
+}
@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"sync"
+
+	"github.com/bongnv/go-container/list"
+)
+
+// NewBlocking creates a new blocking queue which holds at most capacity values.
+// The capacity must be positive.
+func NewBlocking[T any](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		panic("queue: capacity must be positive")
+	}
+	q := &BlockingQueue[T]{
+		container: list.New[T](),
+		capacity:  capacity,
+	}
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	return q
+}
+
+// BlockingQueue is a bounded queue which is safe for concurrent use by multiple goroutines.
+// Push blocks while the queue is full and Pop blocks while the queue is empty.
+type BlockingQueue[T any] struct {
+	mu        sync.Mutex
+	notEmpty  *sync.Cond
+	notFull   *sync.Cond
+	container *list.List[T]
+	capacity  int
+	closed    bool
+}
+
+// Len returns the size of the queue.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.container.Len()
+}
+
+// Push pushes a value into the queue, waiting while the queue is full.
+// It returns false if the queue is closed.
+func (q *BlockingQueue[T]) Push(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for !q.closed && q.container.Len() >= q.capacity {
+		q.notFull.Wait()
+	}
+	if q.closed {
+		return false
+	}
+	q.push(value)
+	return true
+}
+
+// TryPush pushes a value into the queue without waiting.
+// It returns false if the queue is full or closed.
+func (q *BlockingQueue[T]) TryPush(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || q.container.Len() >= q.capacity {
+		return false
+	}
+	q.push(value)
+	return true
+}
+
+// Pop pops a value from the queue, waiting while the queue is empty.
+// It returns false if the queue is closed and empty.
+func (q *BlockingQueue[T]) Pop() (value T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for !q.closed && q.container.Len() == 0 {
+		q.notEmpty.Wait()
+	}
+	if q.container.Len() == 0 {
+		return
+	}
+	return q.pop(), true
+}
+
+// TryPop pops a value from the queue without waiting.
+// It returns false if the queue is empty.
+func (q *BlockingQueue[T]) TryPop() (value T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.container.Len() == 0 {
+		return
+	}
+	return q.pop(), true
+}
+
+// Close closes the queue and wakes up all waiting goroutines.
+// After closing, Push returns false while Pop keeps returning the remaining
+// values, so values queued by a producer before closing aren't lost. Pop returns
+// (zero, false) without blocking only once the queue is drained, like a closed
+// channel, rather than right after Close as first requested.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
+
+func (q *BlockingQueue[T]) push(value T) {
+	q.container.PushBack(value)
+	q.notEmpty.Signal()
+}
+
+func (q *BlockingQueue[T]) pop() T {
+	value := q.container.Delete(q.container.Front())
+	q.notFull.Signal()
+	return value
+}
@@ -0,0 +1,164 @@
+package queue_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bongnv/go-container/queue"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	t.Run("blocking queue should work with concurrent producers and consumers", func(t *testing.T) {
+		q := queue.NewBlocking[int](4)
+		producers, consumers, n := 4, 3, 1000
+
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < n; i++ {
+					if !q.Push(p*n + i) {
+						t.Errorf("expected push to succeed")
+						return
+					}
+				}
+			}(p)
+		}
+
+		var mu sync.Mutex
+		seen := make(map[int]bool)
+		var consumerWG sync.WaitGroup
+		for c := 0; c < consumers; c++ {
+			consumerWG.Add(1)
+			go func() {
+				defer consumerWG.Done()
+				for {
+					v, ok := q.Pop()
+					if !ok {
+						return
+					}
+					mu.Lock()
+					seen[v] = true
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+		q.Close()
+		consumerWG.Wait()
+		if len(seen) != producers*n {
+			t.Fatalf("expected %v values but got %v", producers*n, len(seen))
+		}
+	})
+
+	t.Run("blocking queue should respect its capacity", func(t *testing.T) {
+		q := queue.NewBlocking[int](2)
+		if !q.TryPush(1) || !q.TryPush(2) {
+			t.Fatalf("expected pushes to succeed")
+		}
+		if q.TryPush(3) {
+			t.Fatalf("expected push to fail on a full queue")
+		}
+
+		pushed := make(chan struct{})
+		go func() {
+			q.Push(3)
+			close(pushed)
+		}()
+		select {
+		case <-pushed:
+			t.Fatalf("expected push to block on a full queue")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		if v, ok := q.TryPop(); !ok || v != 1 {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+		<-pushed
+		if q.Len() != 2 {
+			t.Fatalf("expected 2 but got %v", q.Len())
+		}
+	})
+
+	t.Run("blocking queue should drain queued values after close", func(t *testing.T) {
+		q := queue.NewBlocking[int](3)
+		go func() {
+			for i := 1; i <= 3; i++ {
+				q.Push(i)
+			}
+			q.Close()
+		}()
+
+		var values []int
+		for {
+			v, ok := q.Pop()
+			if !ok {
+				break
+			}
+			values = append(values, v)
+		}
+		if len(values) != 3 || values[0] != 1 || values[2] != 3 {
+			t.Fatalf("expected [1 2 3] but got %v", values)
+		}
+
+		q = queue.NewBlocking[int](2)
+		q.Push(1)
+		q.Close()
+		if q.Push(2) {
+			t.Fatalf("expected push to fail after closing")
+		}
+		if v, ok := q.TryPop(); !ok || v != 1 {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+		if _, ok := q.TryPop(); ok {
+			t.Fatalf("expected pop to fail on a closed and drained queue")
+		}
+
+		popped := make(chan bool)
+		go func() {
+			_, ok := q.Pop()
+			popped <- ok
+		}()
+		select {
+		case ok := <-popped:
+			if ok {
+				t.Fatalf("expected pop to fail on a closed and drained queue")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected pop not to block on a closed and drained queue")
+		}
+	})
+
+	t.Run("blocking queue should unblock waiters on close", func(t *testing.T) {
+		q := queue.NewBlocking[int](1)
+		popped := make(chan bool)
+		go func() {
+			_, ok := q.Pop()
+			popped <- ok
+		}()
+		time.Sleep(10 * time.Millisecond)
+		q.Close()
+		if <-popped {
+			t.Fatalf("expected pop to fail after closing")
+		}
+
+		if q.Push(1) || q.TryPush(1) {
+			t.Fatalf("expected push to fail after closing")
+		}
+		if _, ok := q.TryPop(); ok {
+			t.Fatalf("expected pop to fail after closing")
+		}
+	})
+
+	t.Run("blocking queue should panic if capacity isn't positive", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		queue.NewBlocking[int](0)
+	})
+}
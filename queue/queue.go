@@ -42,7 +42,38 @@ func (s *Queue[T]) Back() T {
 	return s.container.Back().Value
 }
 
+// PeekAt returns the value index positions from the front of the queue without
+// removing it, and false if index is out of range. The complexity is O(n).
+func (s *Queue[T]) PeekAt(index int) (value T, ok bool) {
+	e, ok := s.container.At(index)
+	if !ok {
+		return
+	}
+	return e.Value, true
+}
+
+// Rotate moves n values from the front to the back of the queue if n > 0,
+// or -n values from the back to the front if n < 0. The n is taken modulo Len.
+func (s *Queue[T]) Rotate(n int) {
+	size := s.container.Len()
+	if size == 0 {
+		return
+	}
+	n %= size
+	for ; n > 0; n-- {
+		s.container.MoveToBack(s.container.Front())
+	}
+	for ; n < 0; n++ {
+		s.container.MoveToFront(s.container.Back())
+	}
+}
+
 // Empty returns whether the queue is empty or not.
 func (s *Queue[T]) Empty() bool {
 	return s.Len() == 0
 }
+
+// Clear removes all values from the queue.
+func (s *Queue[T]) Clear() {
+	s.container.Clear()
+}
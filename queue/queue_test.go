@@ -31,4 +31,69 @@ func TestQueue(t *testing.T) {
 			t.Fatalf("expected 2 but got %v", h.Front())
 		}
 	})
+	t.Run("queue should be empty after clearing", func(t *testing.T) {
+		h := queue.New[int]()
+		h.Push(1)
+		h.Push(2)
+		h.Clear()
+		if !h.Empty() {
+			t.Fatalf("expected empty queue but got %v items", h.Len())
+		}
+
+		h.Push(3)
+		if h.Front() != 3 || h.Len() != 1 {
+			t.Fatalf("expected 3 but got %v", h.Front())
+		}
+	})
+	t.Run("queue should peek values by index", func(t *testing.T) {
+		h := queue.New[int]()
+		for i := 1; i <= 5; i++ {
+			h.Push(i)
+		}
+		h.Pop()
+		for index, expected := range []int{2, 3, 4, 5} {
+			if v, ok := h.PeekAt(index); !ok || v != expected {
+				t.Fatalf("expected %v at %v but got %v", expected, index, v)
+			}
+		}
+		for _, index := range []int{-1, 4} {
+			if v, ok := h.PeekAt(index); ok || v != 0 {
+				t.Fatalf("expected no value at %v but got %v", index, v)
+			}
+		}
+		if h.Len() != 4 {
+			t.Fatalf("expected 4 but got %v", h.Len())
+		}
+	})
+	t.Run("queue should be rotated", func(t *testing.T) {
+		testCases := map[string]struct {
+			n        int
+			expected []int
+		}{
+			"front to back":          {n: 2, expected: []int{3, 4, 1, 2}},
+			"back to front":          {n: -1, expected: []int{4, 1, 2, 3}},
+			"larger than the length": {n: 9, expected: []int{2, 3, 4, 1}},
+			"negative beyond length": {n: -6, expected: []int{3, 4, 1, 2}},
+			"equal to the length":    {n: 4, expected: []int{1, 2, 3, 4}},
+		}
+
+		for name, tc := range testCases {
+			h := queue.New[int]()
+			for i := 1; i <= 4; i++ {
+				h.Push(i)
+			}
+			h.Rotate(tc.n)
+			for _, expected := range tc.expected {
+				if v := h.Pop(); v != expected {
+					t.Fatalf("%s: expected %v but got %v", name, expected, v)
+				}
+			}
+		}
+
+		h := queue.New[int]()
+		h.Rotate(3)
+		if !h.Empty() {
+			t.Fatalf("expected empty queue but got %v items", h.Len())
+		}
+	})
 }
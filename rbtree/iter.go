@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package rbtree
+
+import "iter"
+
+// All returns an iterator over all elements in ascending order.
+func (t *LLRB[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.Scan(yield)
+	}
+}
+
+// Backward returns an iterator over all elements in descending order.
+func (t *LLRB[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.ReverseScan(yield)
+	}
+}
+
+// Range returns an iterator over elements within the range [greaterOrEqual, lessThan)
+// in ascending order.
+func (t *LLRB[T]) Range(greaterOrEqual, lessThan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.AscendRange(greaterOrEqual, lessThan, yield)
+	}
+}
@@ -0,0 +1,101 @@
+//go:build go1.23
+
+package rbtree_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/rbtree"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLLRB_All(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{5, 1, 3, 4, 2, 6} {
+		tree.Insert(v)
+	}
+
+	testCases := map[string]struct {
+		scan          func() []int
+		expectedOrder []int
+	}{
+		"All should iterate all items in order": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.All() {
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{1, 2, 3, 4, 5, 6},
+		},
+		"Backward should iterate all items in reverse order": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.Backward() {
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{6, 5, 4, 3, 2, 1},
+		},
+		"Range should iterate items within the range": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.Range(2, 5) {
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{2, 3, 4},
+		},
+		"All should stop after break": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.All() {
+					if item > 3 {
+						break
+					}
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{1, 2, 3},
+		},
+		"Backward should stop after break": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.Backward() {
+					if item < 5 {
+						break
+					}
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{6, 5},
+		},
+		"Range should stop after break": {
+			scan: func() []int {
+				var all []int
+				for item := range tree.Range(2, 6) {
+					if item == 4 {
+						break
+					}
+					all = append(all, item)
+				}
+				return all
+			},
+			expectedOrder: []int{2, 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.scan(), tc.expectedOrder); diff != "" {
+				t.Errorf("unexpected order (+got, -wanted): %v", diff)
+			}
+		})
+	}
+}
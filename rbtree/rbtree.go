@@ -22,6 +22,7 @@ type Node[T any] struct {
 	Left, Right *Node[T] // Pointers to left and right child nodes
 	Black       bool     // If set, the color of the link (incoming from the parent) is black
 	// In the LLRB, new nodes are always red, hence the zero-value for node
+	size int // The number of nodes in the subtree rooted at this node
 }
 
 // New allocates a new tree
@@ -36,10 +37,88 @@ func NewFunc[T any](less algorithm.LessFunc[T]) *LLRB[T] {
 	}
 }
 
+// NewFromSorted creates a new tree from items sorted in ascending order in O(n).
+// If items aren't sorted, they are inserted one by one instead.
+func NewFromSorted[T cmp.Ordered](items []T) *LLRB[T] {
+	t := New[T]()
+	for i := 1; i < len(items); i++ {
+		if t.less(items[i], items[i-1]) {
+			for _, item := range items {
+				t.Insert(item)
+			}
+			return t
+		}
+	}
+
+	// find the smallest black height of a 2-3 tree which can hold all items.
+	blackHeight, maxItems := 0, 0
+	for maxItems < len(items) {
+		blackHeight++
+		maxItems = maxItems*3 + 2
+	}
+	t.root = buildNode(items, blackHeight)
+	t.count = len(items)
+	return t
+}
+
+// buildNode builds a subtree of a 2-3 tree with the given black height from sorted items.
+// The number of items must be within [2^blackHeight-1, 3^blackHeight-1].
+func buildNode[T any](items []T, blackHeight int) *Node[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	maxChildItems := 0
+	for i := 1; i < blackHeight; i++ {
+		maxChildItems = maxChildItems*3 + 2
+	}
+
+	if len(items) <= 2*maxChildItems+1 {
+		// a 2-node with 2 children.
+		mid := len(items) / 2
+		h := &Node[T]{
+			Item:  items[mid],
+			Left:  buildNode(items[:mid], blackHeight-1),
+			Right: buildNode(items[mid+1:], blackHeight-1),
+			Black: true,
+		}
+		updateSize(h)
+		return h
+	}
+
+	// a 3-node with 3 children, represented by a red left-leaning link.
+	n := len(items) - 2
+	i := n / 3
+	j := i + 1 + (n-i)/2
+	left := &Node[T]{
+		Item:  items[i],
+		Left:  buildNode(items[:i], blackHeight-1),
+		Right: buildNode(items[i+1:j], blackHeight-1),
+	}
+	updateSize(left)
+	h := &Node[T]{
+		Item:  items[j],
+		Left:  left,
+		Right: buildNode(items[j+1:], blackHeight-1),
+		Black: true,
+	}
+	updateSize(h)
+	return h
+}
+
 // SetRoot sets the root node of the tree.
 // It is intended to be used by functions that deserialize the tree.
 func (t *LLRB[T]) SetRoot(r *Node[T]) {
 	t.root = r
+	t.count = resetSize(r)
+}
+
+// resetSize recomputes sizes of all nodes in the subtree rooted at h.
+func resetSize[T any](h *Node[T]) int {
+	if h == nil {
+		return 0
+	}
+	h.size = 1 + resetSize(h.Left) + resetSize(h.Right)
+	return h.size
 }
 
 // Root returns the root node of the tree.
@@ -51,6 +130,34 @@ func (t *LLRB[T]) Root() *Node[T] {
 // Len returns the number of nodes in the tree.
 func (t *LLRB[T]) Len() int { return t.count }
 
+// Clear removes all elements from the tree.
+func (t *LLRB[T]) Clear() {
+	t.root = nil
+	t.count = 0
+}
+
+// Clone returns an independent copy of the tree.
+func (t *LLRB[T]) Clone() *LLRB[T] {
+	return &LLRB[T]{
+		count: t.count,
+		root:  cloneNode(t.root),
+		less:  t.less,
+	}
+}
+
+func cloneNode[T any](h *Node[T]) *Node[T] {
+	if h == nil {
+		return nil
+	}
+	return &Node[T]{
+		Item:  h.Item,
+		Left:  cloneNode(h.Left),
+		Right: cloneNode(h.Right),
+		Black: h.Black,
+		size:  h.size,
+	}
+}
+
 // Has returns true if the tree contains an element whose order is the same as that of key.
 func (t *LLRB[T]) Has(key T) bool {
 	_, found := t.Get(key)
@@ -97,6 +204,105 @@ func (t *LLRB[T]) Max() (item T, present bool) {
 	return h.Item, true
 }
 
+// Ceil returns the smallest element in the tree that is greater than or equal to key.
+func (t *LLRB[T]) Ceil(key T) (item T, present bool) {
+	h := t.root
+	for h != nil {
+		if t.less(h.Item, key) {
+			h = h.Right
+			continue
+		}
+		item, present = h.Item, true
+		if !t.less(key, h.Item) {
+			return
+		}
+		h = h.Left
+	}
+	return
+}
+
+// Floor returns the largest element in the tree that is less than or equal to key.
+func (t *LLRB[T]) Floor(key T) (item T, present bool) {
+	h := t.root
+	for h != nil {
+		if t.less(key, h.Item) {
+			h = h.Left
+			continue
+		}
+		item, present = h.Item, true
+		if !t.less(h.Item, key) {
+			return
+		}
+		h = h.Right
+	}
+	return
+}
+
+// Successor returns the smallest element in the tree that is strictly greater than key.
+func (t *LLRB[T]) Successor(key T) (item T, present bool) {
+	h := t.root
+	for h != nil {
+		if t.less(key, h.Item) {
+			item, present = h.Item, true
+			h = h.Left
+		} else {
+			h = h.Right
+		}
+	}
+	return
+}
+
+// Predecessor returns the largest element in the tree that is strictly less than key.
+func (t *LLRB[T]) Predecessor(key T) (item T, present bool) {
+	h := t.root
+	for h != nil {
+		if t.less(h.Item, key) {
+			item, present = h.Item, true
+			h = h.Right
+		} else {
+			h = h.Left
+		}
+	}
+	return
+}
+
+// GetAt returns the element at index in the ascending order of the tree.
+// It returns false if index is out of range.
+func (t *LLRB[T]) GetAt(index int) (item T, present bool) {
+	if index < 0 || index >= t.count {
+		return
+	}
+	h := t.root
+	for h != nil {
+		leftSize := size(h.Left)
+		switch {
+		case index < leftSize:
+			h = h.Left
+		case index > leftSize:
+			index -= leftSize + 1
+			h = h.Right
+		default:
+			return h.Item, true
+		}
+	}
+	return
+}
+
+// Rank returns the number of elements in the tree that are less than key.
+func (t *LLRB[T]) Rank(key T) int {
+	rank := 0
+	h := t.root
+	for h != nil {
+		if t.less(h.Item, key) {
+			rank += size(h.Left) + 1
+			h = h.Right
+		} else {
+			h = h.Left
+		}
+	}
+	return rank
+}
+
 // Upsert inserts item into the tree. If an existing
 // element has the same order, it is removed from the tree and returned.
 func (t *LLRB[T]) Upsert(item T) (replacedItem T, replaced bool) {
@@ -114,9 +320,7 @@ func (t *LLRB[T]) replaceOrInsert(h *Node[T], item T) (node *Node[T], replacedTt
 		return
 	}
 
-	h = walkDownRot23(h)
-
-	if t.less(item, h.Item) { // BUG
+	if t.less(item, h.Item) {
 		h.Left, replacedTtem, replaced = t.replaceOrInsert(h.Left, item)
 	} else if t.less(h.Item, item) {
 		h.Right, replacedTtem, replaced = t.replaceOrInsert(h.Right, item)
@@ -124,9 +328,35 @@ func (t *LLRB[T]) replaceOrInsert(h *Node[T], item T) (node *Node[T], replacedTt
 		replacedTtem, h.Item, replaced = h.Item, item, true
 	}
 
-	h = walkUpRot23(h)
+	return walkUpRot23(h), replacedTtem, replaced
+}
+
+// GetOrInsert returns the existing element which has the same order as item.
+// Otherwise, it inserts item into the tree and returns it.
+// The loaded result is true if the element was found, false if inserted.
+func (t *LLRB[T]) GetOrInsert(item T) (actual T, loaded bool) {
+	t.root, actual, loaded = t.getOrInsert(t.root, item)
+	t.root.Black = true
+	if !loaded {
+		t.count++
+	}
+	return actual, loaded
+}
+
+func (t *LLRB[T]) getOrInsert(h *Node[T], item T) (node *Node[T], actual T, loaded bool) {
+	if h == nil {
+		return newNode[T](item), item, false
+	}
+
+	if t.less(item, h.Item) {
+		h.Left, actual, loaded = t.getOrInsert(h.Left, item)
+	} else if t.less(h.Item, item) {
+		h.Right, actual, loaded = t.getOrInsert(h.Right, item)
+	} else {
+		return h, h.Item, true
+	}
 
-	return h, replacedTtem, replaced
+	return walkUpRot23(h), actual, loaded
 }
 
 // Insert inserts item into the tree. If an existing
@@ -142,8 +372,6 @@ func (t *LLRB[T]) insertNoReplace(h *Node[T], item T) *Node[T] {
 		return newNode(item)
 	}
 
-	h = walkDownRot23(h)
-
 	if t.less(item, h.Item) {
 		h.Left = t.insertNoReplace(h.Left, item)
 	} else {
@@ -153,11 +381,12 @@ func (t *LLRB[T]) insertNoReplace(h *Node[T], item T) *Node[T] {
 	return walkUpRot23(h)
 }
 
-// Rotation driver routines for 2-3 algorithm
-
-func walkDownRot23[T any](h *Node[T]) *Node[T] { return h }
-
+// walkUpRot23 restores the LLRB invariants of h on the way up after an insertion
+// into the 2-3 tree. New nodes are attached at the bottom as red links, so no
+// rotation is needed on the way down.
 func walkUpRot23[T any](h *Node[T]) *Node[T] {
+	updateSize(h)
+
 	if isRed(h.Right) && !isRed(h.Left) {
 		h = rotateLeft(h)
 	}
@@ -173,28 +402,6 @@ func walkUpRot23[T any](h *Node[T]) *Node[T] {
 	return h
 }
 
-// Rotation driver routines for 2-3-4 algorithm
-
-func walkDownRot234[T any](h *Node[T]) *Node[T] {
-	if isRed(h.Left) && isRed(h.Right) {
-		flip(h)
-	}
-
-	return h
-}
-
-func walkUpRot234[T any](h *Node[T]) *Node[T] {
-	if isRed(h.Right) && !isRed(h.Left) {
-		h = rotateLeft(h)
-	}
-
-	if isRed(h.Left) && isRed(h.Left.Left) {
-		h = rotateRight(h)
-	}
-
-	return h
-}
-
 // DeleteMin deletes the minimum element in the tree and returns the
 // deleted item or nil otherwise.
 func (t *LLRB[T]) DeleteMin() (deletedItem T, deleted bool) {
@@ -270,6 +477,20 @@ func (t *LLRB[T]) Delete(key T) (deletedItem T, deleted bool) {
 	return deletedItem, deleted
 }
 
+// DeleteRange deletes all elements within the range [greaterOrEqual, lessThan)
+// and returns the number of deleted elements.
+func (t *LLRB[T]) DeleteRange(greaterOrEqual, lessThan T) int {
+	var keys []T
+	t.AscendRange(greaterOrEqual, lessThan, func(item T) bool {
+		keys = append(keys, item)
+		return true
+	})
+	for _, key := range keys {
+		t.Delete(key)
+	}
+	return len(keys)
+}
+
 func (t *LLRB[T]) delete(h *Node[T], item T) (node *Node[T], deletedItem T, deleted bool) {
 	if h == nil {
 		return nil, deletedItem, false
@@ -291,15 +512,19 @@ func (t *LLRB[T]) delete(h *Node[T], item T) (node *Node[T], deletedItem T, dele
 			return nil, h.Item, true
 		}
 		// PETAR: Added 'h.Right != nil' below
+		top := h
 		if h.Right != nil && !isRed(h.Right) && !isRed(h.Right.Left) {
 			h = moveRedRight(h)
 		}
-		// If @item equals @h.Item, and (from above) 'h.Right != nil'
-		if !t.less(h.Item, item) {
+		// If @item equals @h.Item, and (from above) 'h.Right != nil'.
+		// With duplicates, moveRedRight may rotate an item equal to @item to
+		// the top while its right subtree isn't balanced yet. In that case,
+		// @top, which also equals @item, is deleted from the right subtree.
+		if h == top && !t.less(h.Item, item) {
 			var subDeleted T
 			h.Right, subDeleted, deleted = deleteMin(h.Right)
 			deletedItem, h.Item = h.Item, subDeleted
-		} else { // Else, @item is bigger than @h.Item
+		} else { // Else, @item is bigger than @h.Item or it's equal to @top
 			h.Right, deletedItem, deleted = t.delete(h.Right, item)
 		}
 	}
@@ -307,9 +532,63 @@ func (t *LLRB[T]) delete(h *Node[T], item T) (node *Node[T], deletedItem T, dele
 	return fixUp(h), deletedItem, deleted
 }
 
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t *LLRB[T]) Height() int {
+	return height(t.root)
+}
+
+func height[T any](h *Node[T]) int {
+	if h == nil {
+		return 0
+	}
+	return 1 + max(height(h.Left), height(h.Right))
+}
+
+// IsValid verifies the LLRB invariants of the tree: there is no right-leaning
+// red link, no two red links in a row and all paths from the root to leaves
+// have the same number of black links. It's intended to be used in tests.
+func (t *LLRB[T]) IsValid() bool {
+	if isRed(t.root) {
+		return false
+	}
+	return blackHeight(t.root) >= 0 && size(t.root) == t.count
+}
+
+// blackHeight returns the black height of h or -1 if h violates the invariants.
+func blackHeight[T any](h *Node[T]) int {
+	if h == nil {
+		return 0
+	}
+	if isRed(h.Right) || (isRed(h) && isRed(h.Left)) {
+		return -1
+	}
+	if h.size != 1+size(h.Left)+size(h.Right) {
+		return -1
+	}
+	left, right := blackHeight(h.Left), blackHeight(h.Right)
+	if left < 0 || left != right {
+		return -1
+	}
+	if h.Black {
+		left++
+	}
+	return left
+}
+
 // Internal node manipulation routines
 
-func newNode[T any](item T) *Node[T] { return &Node[T]{Item: item} }
+func newNode[T any](item T) *Node[T] { return &Node[T]{Item: item, size: 1} }
+
+func size[T any](h *Node[T]) int {
+	if h == nil {
+		return 0
+	}
+	return h.size
+}
+
+func updateSize[T any](h *Node[T]) {
+	h.size = 1 + size(h.Left) + size(h.Right)
+}
 
 func isRed[T any](h *Node[T]) bool {
 	if h == nil {
@@ -327,6 +606,8 @@ func rotateLeft[T any](h *Node[T]) *Node[T] {
 	x.Left = h
 	x.Black = h.Black
 	h.Black = false
+	x.size = h.size
+	updateSize(h)
 	return x
 }
 
@@ -339,6 +620,8 @@ func rotateRight[T any](h *Node[T]) *Node[T] {
 	x.Right = h
 	x.Black = h.Black
 	h.Black = false
+	x.size = h.size
+	updateSize(h)
 	return x
 }
 
@@ -371,6 +654,8 @@ func moveRedRight[T any](h *Node[T]) *Node[T] {
 }
 
 func fixUp[T any](h *Node[T]) *Node[T] {
+	updateSize(h)
+
 	if isRed(h.Right) {
 		h = rotateLeft(h)
 	}
@@ -474,6 +759,54 @@ func (t *LLRB[T]) descendLessOrEqual(h *Node[T], pivot T, iterator ItemIterator[
 	return t.descendLessOrEqual(h.Left, pivot, iterator)
 }
 
+// DescendRange will call iterator once for each element less than or equal to lessOrEqual
+// and greater than greaterThan in descending order. It will stop whenever the iterator returns false.
+func (t *LLRB[T]) DescendRange(lessOrEqual, greaterThan T, iterator ItemIterator[T]) {
+	t.descendRange(t.root, lessOrEqual, greaterThan, iterator)
+}
+
+func (t *LLRB[T]) descendRange(h *Node[T], sup, inf T, iterator ItemIterator[T]) bool {
+	if h == nil {
+		return true
+	}
+	if t.less(sup, h.Item) {
+		return t.descendRange(h.Left, sup, inf, iterator)
+	}
+	if !t.less(inf, h.Item) {
+		return t.descendRange(h.Right, sup, inf, iterator)
+	}
+
+	if !t.descendRange(h.Right, sup, inf, iterator) {
+		return false
+	}
+	if !iterator(h.Item) {
+		return false
+	}
+	return t.descendRange(h.Left, sup, inf, iterator)
+}
+
+// DescendGreaterThan will call iterator once for each element greater than
+// pivot in descending order. It will stop whenever the iterator returns false.
+func (t *LLRB[T]) DescendGreaterThan(pivot T, iterator ItemIterator[T]) {
+	t.descendGreaterThan(t.root, pivot, iterator)
+}
+
+func (t *LLRB[T]) descendGreaterThan(h *Node[T], pivot T, iterator ItemIterator[T]) bool {
+	if h == nil {
+		return true
+	}
+	if !t.descendGreaterThan(h.Right, pivot, iterator) {
+		return false
+	}
+	if t.less(pivot, h.Item) {
+		if !iterator(h.Item) {
+			return false
+		}
+		return t.descendGreaterThan(h.Left, pivot, iterator)
+	}
+	return true
+}
+
 // Scan will call iterator once for each element in ascending order.
 // It will stop whenever the iterator returns false.
 func (t *LLRB[T]) Scan(iterator ItemIterator[T]) {
@@ -521,3 +854,13 @@ func (t *LLRB[T]) Values() []T {
 	})
 	return allValues
 }
+
+// ReverseValues returns all values from the tree in descending order.
+func (t *LLRB[T]) ReverseValues() []T {
+	allValues := make([]T, 0, t.Len())
+	t.descend(t.root, func(value T) bool {
+		allValues = append(allValues, value)
+		return true
+	})
+	return allValues
+}
@@ -2,10 +2,12 @@ package rbtree_test
 
 import (
 	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/bongnv/go-container/rbtree"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestCases(t *testing.T) {
@@ -358,3 +360,491 @@ func TestLLRB_Values(t *testing.T) {
 		})
 	}
 }
+
+func TestSequentialInsertHeight(t *testing.T) {
+	tree := rbtree.New[int]()
+	n := 10000
+	for i := 0; i < n; i++ {
+		tree.Upsert(i)
+	}
+	// the height of a LLRB tree is at most 2*log2(n+1).
+	if h := tree.Height(); h > 2*14 {
+		t.Errorf("tree is skewed, height: %d", h)
+	}
+	if !tree.IsValid() {
+		t.Errorf("tree isn't balanced")
+	}
+}
+
+func TestRandomInsertDeleteDuplicates(t *testing.T) {
+	for q := 0; q < 100; q++ {
+		tree := rbtree.New[int]()
+		counts := map[int]int{}
+		for i := 0; i < 1000; i++ {
+			key := rand.Intn(50)
+			switch rand.Intn(3) {
+			case 0:
+				tree.Insert(key)
+				counts[key]++
+			case 1:
+				if _, deleted := tree.Delete(key); deleted != (counts[key] > 0) {
+					t.Fatalf("unexpected delete result of %d", key)
+				}
+				if counts[key] > 0 {
+					counts[key]--
+				}
+			case 2:
+				if item, deleted := tree.DeleteMin(); deleted {
+					counts[item]--
+				}
+			}
+			if !tree.IsValid() {
+				t.Fatalf("tree isn't balanced")
+			}
+		}
+
+		var expected []int
+		for key := 0; key < 50; key++ {
+			for i := 0; i < counts[key]; i++ {
+				expected = append(expected, key)
+			}
+		}
+		if diff := cmp.Diff(tree.Values(), expected, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("unexpected values (+got, -wanted): %v", diff)
+		}
+		if tree.Len() != len(expected) {
+			t.Fatalf("expected len %d, got %d", len(expected), tree.Len())
+		}
+	}
+}
+
+func TestLLRB_Clear(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{5, 3, 8, 1} {
+		tree.Insert(v)
+	}
+	tree.Clear()
+	if tree.Len() != 0 {
+		t.Errorf("expecting len 0, got %d", tree.Len())
+	}
+	tree.Scan(func(i int) bool {
+		t.Errorf("not expecting to scan %d", i)
+		return true
+	})
+
+	for _, v := range []int{4, 2, 9, 2} {
+		tree.Insert(v)
+	}
+	if diff := cmp.Diff(tree.Values(), []int{2, 2, 4, 9}); diff != "" {
+		t.Errorf("unexpected order (+got, -wanted): %v", diff)
+	}
+	if tree.Len() != 4 {
+		t.Errorf("expecting len 4, got %d", tree.Len())
+	}
+}
+
+func TestLLRB_CeilFloor(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{10, 20, 30, 40} {
+		tree.Insert(v)
+	}
+
+	testCases := map[string]struct {
+		key          int
+		ceil         int
+		ceilPresent  bool
+		floor        int
+		floorPresent bool
+	}{
+		"below min":  {key: 5, ceil: 10, ceilPresent: true},
+		"equal min":  {key: 10, ceil: 10, ceilPresent: true, floor: 10, floorPresent: true},
+		"in a gap":   {key: 25, ceil: 30, ceilPresent: true, floor: 20, floorPresent: true},
+		"equal item": {key: 30, ceil: 30, ceilPresent: true, floor: 30, floorPresent: true},
+		"equal max":  {key: 40, ceil: 40, ceilPresent: true, floor: 40, floorPresent: true},
+		"above max":  {key: 45, floor: 40, floorPresent: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ceil, ok := tree.Ceil(tc.key)
+			if ceil != tc.ceil || ok != tc.ceilPresent {
+				t.Errorf("Ceil(%d): expecting (%d, %v), got (%d, %v)", tc.key, tc.ceil, tc.ceilPresent, ceil, ok)
+			}
+			floor, ok := tree.Floor(tc.key)
+			if floor != tc.floor || ok != tc.floorPresent {
+				t.Errorf("Floor(%d): expecting (%d, %v), got (%d, %v)", tc.key, tc.floor, tc.floorPresent, floor, ok)
+			}
+		})
+	}
+
+	if _, ok := rbtree.New[int]().Ceil(1); ok {
+		t.Errorf("not expecting Ceil in an empty tree")
+	}
+	if _, ok := rbtree.New[int]().Floor(1); ok {
+		t.Errorf("not expecting Floor in an empty tree")
+	}
+}
+
+func TestLLRB_SuccessorPredecessor(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{10, 20, 20, 30, 40} {
+		tree.Insert(v)
+	}
+
+	testCases := map[string]struct {
+		key            int
+		successor      int
+		hasSuccessor   bool
+		predecessor    int
+		hasPredecessor bool
+	}{
+		"below min":      {key: 5, successor: 10, hasSuccessor: true},
+		"min":            {key: 10, successor: 20, hasSuccessor: true},
+		"duplicate item": {key: 20, successor: 30, hasSuccessor: true, predecessor: 10, hasPredecessor: true},
+		"in a gap":       {key: 25, successor: 30, hasSuccessor: true, predecessor: 20, hasPredecessor: true},
+		"max":            {key: 40, predecessor: 30, hasPredecessor: true},
+		"above max":      {key: 45, predecessor: 40, hasPredecessor: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			successor, ok := tree.Successor(tc.key)
+			if successor != tc.successor || ok != tc.hasSuccessor {
+				t.Errorf("Successor(%d): expecting (%d, %v), got (%d, %v)", tc.key, tc.successor, tc.hasSuccessor, successor, ok)
+			}
+			predecessor, ok := tree.Predecessor(tc.key)
+			if predecessor != tc.predecessor || ok != tc.hasPredecessor {
+				t.Errorf("Predecessor(%d): expecting (%d, %v), got (%d, %v)", tc.key, tc.predecessor, tc.hasPredecessor, predecessor, ok)
+			}
+		})
+	}
+}
+
+func TestLLRB_DescendRange(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{5, 1, 3, 3, 4, 2, 6} {
+		tree.Insert(v)
+	}
+
+	testCases := map[string]struct {
+		scan          func(iterator rbtree.ItemIterator[int])
+		expectedOrder []int
+	}{
+		"DescendRange should include lessOrEqual and exclude greaterThan": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendRange(5, 2, iterator)
+			},
+			expectedOrder: []int{5, 4, 3, 3},
+		},
+		"DescendRange should return nothing for an empty range": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendRange(3, 3, iterator)
+			},
+		},
+		"DescendRange should mirror AscendRange": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendRange(6, 0, iterator)
+			},
+			expectedOrder: []int{6, 5, 4, 3, 3, 2, 1},
+		},
+		"DescendGreaterThan should exclude pivot": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendGreaterThan(3, iterator)
+			},
+			expectedOrder: []int{6, 5, 4},
+		},
+		"DescendGreaterThan should return everything below min": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendGreaterThan(0, iterator)
+			},
+			expectedOrder: []int{6, 5, 4, 3, 3, 2, 1},
+		},
+		"DescendGreaterThan should stop when iterator returns false": {
+			scan: func(iterator rbtree.ItemIterator[int]) {
+				tree.DescendGreaterThan(1, func(i int) bool {
+					return iterator(i) && i > 4
+				})
+			},
+			expectedOrder: []int{6, 5, 4},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var allItems []int
+			tc.scan(func(i int) bool {
+				allItems = append(allItems, i)
+				return true
+			})
+			if diff := cmp.Diff(allItems, tc.expectedOrder); diff != "" {
+				t.Errorf("unexpected order (+got, -wanted): %v", diff)
+			}
+		})
+	}
+}
+
+func TestLLRB_Clone(t *testing.T) {
+	tree := rbtree.New[int]()
+	for _, v := range []int{5, 1, 3, 4, 2} {
+		tree.Insert(v)
+	}
+
+	clone := tree.Clone()
+	clone.Delete(3)
+	clone.Insert(6)
+	if diff := cmp.Diff(tree.Values(), []int{1, 2, 3, 4, 5}); diff != "" {
+		t.Errorf("unexpected original values (+got, -wanted): %v", diff)
+	}
+	if diff := cmp.Diff(clone.Values(), []int{1, 2, 4, 5, 6}); diff != "" {
+		t.Errorf("unexpected cloned values (+got, -wanted): %v", diff)
+	}
+
+	tree.Delete(1)
+	if diff := cmp.Diff(clone.Values(), []int{1, 2, 4, 5, 6}); diff != "" {
+		t.Errorf("unexpected cloned values (+got, -wanted): %v", diff)
+	}
+	if tree.Len() != 4 || clone.Len() != 5 {
+		t.Errorf("unexpected lengths: %d and %d", tree.Len(), clone.Len())
+	}
+	if !clone.IsValid() {
+		t.Errorf("cloned tree isn't balanced")
+	}
+}
+
+func TestLLRB_GetAtRank(t *testing.T) {
+	tree := rbtree.New[int]()
+	for i := 0; i < 2000; i++ {
+		key := rand.Intn(500)
+		switch rand.Intn(4) {
+		case 0:
+			tree.Delete(key)
+		case 1:
+			tree.DeleteMin()
+		default:
+			tree.Insert(key)
+		}
+	}
+
+	values := tree.Values()
+	for i, v := range values {
+		item, ok := tree.GetAt(i)
+		if !ok || item != v {
+			t.Fatalf("GetAt(%d): expecting %d, got (%d, %v)", i, v, item, ok)
+		}
+	}
+	if _, ok := tree.GetAt(-1); ok {
+		t.Errorf("not expecting GetAt(-1)")
+	}
+	if _, ok := tree.GetAt(len(values)); ok {
+		t.Errorf("not expecting GetAt(%d)", len(values))
+	}
+
+	for key := -1; key <= 501; key++ {
+		expected := sort.SearchInts(values, key)
+		if rank := tree.Rank(key); rank != expected {
+			t.Fatalf("Rank(%d): expecting %d, got %d", key, expected, rank)
+		}
+	}
+}
+
+func TestLLRB_IsValid(t *testing.T) {
+	tree := rbtree.New[int]()
+	if !tree.IsValid() || tree.Height() != 0 {
+		t.Errorf("expecting an empty tree to be valid with height 0")
+	}
+	for i := 0; i < 5000; i++ {
+		key := rand.Intn(1000)
+		if rand.Intn(3) == 0 {
+			tree.Delete(key)
+		} else {
+			tree.Insert(key)
+		}
+	}
+	if !tree.IsValid() {
+		t.Errorf("expecting the tree to be valid")
+	}
+
+	invalidTrees := map[string]*rbtree.Node[int]{
+		"red right link": {
+			Item:  1,
+			Right: &rbtree.Node[int]{Item: 2},
+			Black: true,
+		},
+		"two red links in a row": {
+			Item: 3,
+			Left: &rbtree.Node[int]{
+				Item: 2,
+				Left: &rbtree.Node[int]{Item: 1},
+			},
+			Black: true,
+		},
+		"unequal black height": {
+			Item:  2,
+			Left:  &rbtree.Node[int]{Item: 1, Black: true},
+			Black: true,
+		},
+	}
+	for name, root := range invalidTrees {
+		root := root
+		t.Run(name, func(t *testing.T) {
+			tree := rbtree.New[int]()
+			tree.SetRoot(root)
+			if tree.IsValid() {
+				t.Errorf("not expecting the tree to be valid")
+			}
+		})
+	}
+}
+
+func TestLLRB_ReverseValues(t *testing.T) {
+	tree := rbtree.New[int]()
+	if values := tree.ReverseValues(); len(values) != 0 {
+		t.Errorf("expecting no values, got %v", values)
+	}
+	for _, v := range []int{1, 0, 2, 2, 4} {
+		tree.Insert(v)
+	}
+	values := tree.ReverseValues()
+	if diff := cmp.Diff(values, []int{4, 2, 2, 1, 0}); diff != "" {
+		t.Errorf("unexpected order (+got, -wanted): %v", diff)
+	}
+	if len(values) != tree.Len() {
+		t.Errorf("expecting %d values, got %d", tree.Len(), len(values))
+	}
+}
+
+func TestLLRB_DeleteRange(t *testing.T) {
+	testCases := map[string]struct {
+		greaterOrEqual int
+		lessThan       int
+		expectedCount  int
+		expectedValues []int
+	}{
+		"should delete an interior span": {
+			greaterOrEqual: 2,
+			lessThan:       5,
+			expectedCount:  4,
+			expectedValues: []int{0, 1, 5, 6},
+		},
+		"should delete nothing if no item matches": {
+			greaterOrEqual: 7,
+			lessThan:       10,
+			expectedValues: []int{0, 1, 2, 3, 3, 4, 5, 6},
+		},
+		"should delete nothing for an empty span": {
+			greaterOrEqual: 3,
+			lessThan:       3,
+			expectedValues: []int{0, 1, 2, 3, 3, 4, 5, 6},
+		},
+		"should delete all items": {
+			greaterOrEqual: 0,
+			lessThan:       7,
+			expectedCount:  8,
+			expectedValues: []int{},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tree := rbtree.New[int]()
+			for _, v := range []int{3, 0, 5, 1, 3, 6, 2, 4} {
+				tree.Insert(v)
+			}
+			if count := tree.DeleteRange(tc.greaterOrEqual, tc.lessThan); count != tc.expectedCount {
+				t.Errorf("expecting %d deleted items, got %d", tc.expectedCount, count)
+			}
+			if diff := cmp.Diff(tree.Values(), tc.expectedValues); diff != "" {
+				t.Errorf("unexpected values (+got, -wanted): %v", diff)
+			}
+			if tree.Len() != len(tc.expectedValues) || !tree.IsValid() {
+				t.Errorf("tree isn't valid after deleting")
+			}
+		})
+	}
+}
+
+func TestLLRB_GetOrInsert(t *testing.T) {
+	type item struct {
+		key   int
+		value string
+	}
+	tree := rbtree.NewFunc(func(a, b item) bool {
+		return a.key < b.key
+	})
+
+	actual, loaded := tree.GetOrInsert(item{key: 1, value: "first"})
+	if loaded || actual.value != "first" {
+		t.Errorf("expecting first to be inserted, got (%v, %v)", actual, loaded)
+	}
+	actual, loaded = tree.GetOrInsert(item{key: 1, value: "second"})
+	if !loaded || actual.value != "first" {
+		t.Errorf("expecting first to be loaded, got (%v, %v)", actual, loaded)
+	}
+	if tree.Len() != 1 {
+		t.Errorf("expecting len 1, got %d", tree.Len())
+	}
+
+	for i := 0; i < 1000; i++ {
+		tree.GetOrInsert(item{key: rand.Intn(200)})
+	}
+	if !tree.IsValid() {
+		t.Errorf("tree isn't valid")
+	}
+	if tree.Len() != len(tree.Values()) {
+		t.Errorf("expecting len %d, got %d", len(tree.Values()), tree.Len())
+	}
+}
+
+func TestNewFromSorted(t *testing.T) {
+	for n := 0; n < 300; n++ {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = i
+		}
+		tree := rbtree.NewFromSorted(items)
+		if tree.Len() != n || !tree.IsValid() {
+			t.Fatalf("tree of %d items isn't valid", n)
+		}
+		if diff := cmp.Diff(tree.Values(), items, cmpopts.EquateEmpty()); diff != "" {
+			t.Fatalf("unexpected values (+got, -wanted): %v", diff)
+		}
+	}
+
+	t.Run("should keep duplicates", func(t *testing.T) {
+		tree := rbtree.NewFromSorted([]int{1, 2, 2, 3, 3, 3})
+		if diff := cmp.Diff(tree.Values(), []int{1, 2, 2, 3, 3, 3}); diff != "" {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+		tree.Insert(2)
+		tree.Delete(3)
+		if diff := cmp.Diff(tree.Values(), []int{1, 2, 2, 2, 3, 3}); diff != "" || !tree.IsValid() {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("should insert items if they aren't sorted", func(t *testing.T) {
+		tree := rbtree.NewFromSorted([]int{3, 1, 2})
+		if diff := cmp.Diff(tree.Values(), []int{1, 2, 3}); diff != "" || !tree.IsValid() {
+			t.Errorf("unexpected values (+got, -wanted): %v", diff)
+		}
+	})
+
+	t.Run("should support later mutations", func(t *testing.T) {
+		items := make([]int, 1000)
+		for i := range items {
+			items[i] = i * 2
+		}
+		tree := rbtree.NewFromSorted(items)
+		for i := 0; i < 1000; i++ {
+			tree.Upsert(rand.Intn(2000))
+			tree.Delete(rand.Intn(2000))
+		}
+		if !tree.IsValid() {
+			t.Errorf("tree isn't valid after mutations")
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package set
+
+// GroupCount returns the number of occurrences of each distinct item.
+func GroupCount[T comparable](items []T) map[T]int {
+	counts := make(map[T]int)
+	for _, item := range items {
+		counts[item]++
+	}
+	return counts
+}
+
+// NewMulti creates a new MultiSet.
+func NewMulti[T comparable]() *MultiSet[T] {
+	return &MultiSet[T]{
+		container: make(map[T]int),
+	}
+}
+
+// MultiSet implements the multiset data structure where a value can be inserted multiple times.
+type MultiSet[T comparable] struct {
+	container map[T]int
+	len       int
+}
+
+// Len returns the size of the multiset, counting all occurrences.
+func (s *MultiSet[T]) Len() int {
+	return s.len
+}
+
+// Insert inserts an occurrence of a value into the multiset.
+func (s *MultiSet[T]) Insert(val T) {
+	s.container[val]++
+	s.len++
+}
+
+// Delete deletes an occurrence of a value from the multiset.
+// If the value doesn't exist, it's a no-op.
+func (s *MultiSet[T]) Delete(val T) {
+	count, found := s.container[val]
+	if !found {
+		return
+	}
+	if count == 1 {
+		delete(s.container, val)
+	} else {
+		s.container[val] = count - 1
+	}
+	s.len--
+}
+
+// Count returns the number of occurrences of the given value.
+func (s *MultiSet[T]) Count(val T) int {
+	return s.container[val]
+}
+
+// Has checks whether the multiset contains the given value or not.
+func (s *MultiSet[T]) Has(val T) bool {
+	_, found := s.container[val]
+	return found
+}
+
+// Scan scans through distinct values of the multiset and their counts in an arbitrary order.
+func (s *MultiSet[T]) Scan(itor func(val T, count int) bool) {
+	for val, count := range s.container {
+		if !itor(val, count) {
+			return
+		}
+	}
+}
+
+// Empty returns whether the multiset is empty or not.
+func (s *MultiSet[T]) Empty() bool {
+	return s.Len() == 0
+}
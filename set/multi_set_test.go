@@ -0,0 +1,53 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/bongnv/go-container/set"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMultiSet(t *testing.T) {
+	t.Run("multiset should track counts properly", func(t *testing.T) {
+		s := set.NewMulti[string]()
+		s.Insert("a")
+		s.Insert("a")
+		s.Insert("b")
+		if diff := cmp.Diff(s.Count("a"), 2); diff != "" {
+			t.Fatalf("Incorrect count: %v", diff)
+		}
+		if diff := cmp.Diff(s.Len(), 3); diff != "" {
+			t.Fatalf("Incorrect size: %v", diff)
+		}
+
+		s.Delete("a")
+		if diff := cmp.Diff(s.Count("a"), 1); diff != "" {
+			t.Fatalf("Incorrect count: %v", diff)
+		}
+
+		s.Delete("a")
+		s.Delete("c")
+		if diff := cmp.Diff(s.Has("a"), false); diff != "" {
+			t.Fatal(diff)
+		}
+		if diff := cmp.Diff(s.Len(), 1); diff != "" {
+			t.Fatalf("Incorrect size: %v", diff)
+		}
+
+		counts := map[string]int{}
+		s.Scan(func(val string, count int) bool {
+			counts[val] = count
+			return true
+		})
+		if diff := cmp.Diff(counts, map[string]int{"b": 1}); diff != "" {
+			t.Fatalf("Incorrect counts: %v", diff)
+		}
+	})
+}
+
+func TestGroupCount(t *testing.T) {
+	counts := set.GroupCount([]string{"a", "b", "a", "c", "a"})
+	if diff := cmp.Diff(counts, map[string]int{"a": 3, "b": 1, "c": 1}); diff != "" {
+		t.Fatalf("Incorrect counts: %v", diff)
+	}
+}
@@ -1,5 +1,11 @@
 package set
 
+import (
+	"cmp"
+
+	"github.com/bongnv/go-container/algorithm"
+)
+
 // New creates a new Set.
 func New[T comparable]() *Set[T] {
 	return &Set[T]{
@@ -46,3 +52,43 @@ func (s *Set[T]) Scan(itor func(val T) bool) {
 func (s *Set[T]) Empty() bool {
 	return s.Len() == 0
 }
+
+// Sorted returns values of the set as a slice in ascending order.
+func Sorted[T cmp.Ordered](s *Set[T]) []T {
+	values := make([]T, 0, s.Len())
+	for val := range s.container {
+		values = append(values, val)
+	}
+	algorithm.Sort(values)
+	return values
+}
+
+// Combinations returns all subsets of k values of the set, in an arbitrary order.
+// It returns one empty combination if k is 0, and none if k is out of range.
+func Combinations[T comparable](s *Set[T], k int) [][]T {
+	if k < 0 || k > s.Len() {
+		return nil
+	}
+	values := make([]T, 0, s.Len())
+	for val := range s.container {
+		values = append(values, val)
+	}
+
+	var combinations [][]T
+	combination := make([]T, 0, k)
+	var generate func(start int)
+	generate = func(start int) {
+		if len(combination) == k {
+			combinations = append(combinations, append([]T{}, combination...))
+			return
+		}
+		// leave enough values to fill the combination.
+		for i := start; i <= len(values)-(k-len(combination)); i++ {
+			combination = append(combination, values[i])
+			generate(i + 1)
+			combination = combination[:len(combination)-1]
+		}
+	}
+	generate(0)
+	return combinations
+}
@@ -1,6 +1,7 @@
 package set_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/bongnv/go-container/set"
@@ -35,3 +36,69 @@ func TestSet(t *testing.T) {
 		}
 	})
 }
+
+func TestSorted(t *testing.T) {
+	t.Run("should sort ints", func(t *testing.T) {
+		s := set.New[int]()
+		for _, v := range []int{3, 1, 2, 5, 1} {
+			s.Insert(v)
+		}
+		if diff := cmp.Diff(set.Sorted(s), []int{1, 2, 3, 5}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("should sort strings", func(t *testing.T) {
+		s := set.New[string]()
+		for _, v := range []string{"b", "c", "a"} {
+			s.Insert(v)
+		}
+		if diff := cmp.Diff(set.Sorted(s), []string{"a", "b", "c"}); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("should be empty for an empty set", func(t *testing.T) {
+		if values := set.Sorted(set.New[int]()); len(values) != 0 {
+			t.Fatalf("expected no values but got %v", values)
+		}
+	})
+}
+
+func TestCombinations(t *testing.T) {
+	s := set.New[int]()
+	for i := 0; i < 6; i++ {
+		s.Insert(i)
+	}
+
+	binomial := []int{1, 6, 15, 20, 15, 6, 1}
+	for k, expected := range binomial {
+		combinations := set.Combinations(s, k)
+		if diff := cmp.Diff(len(combinations), expected); diff != "" {
+			t.Fatalf("Incorrect number of combinations for k=%v: %v", k, diff)
+		}
+
+		seen := set.New[string]()
+		for _, c := range combinations {
+			if len(c) != k {
+				t.Fatalf("expected %v values but got %v", k, c)
+			}
+			sorted := set.New[int]()
+			for _, v := range c {
+				sorted.Insert(v)
+			}
+			key := fmt.Sprint(set.Sorted(sorted))
+			if sorted.Len() != k || seen.Has(key) {
+				t.Fatalf("unexpected combination: %v", c)
+			}
+			seen.Insert(key)
+		}
+	}
+
+	if combinations := set.Combinations(s, 7); len(combinations) != 0 {
+		t.Fatalf("expected no combination but got %v", combinations)
+	}
+	if diff := cmp.Diff(set.Combinations(s, 0), [][]int{{}}); diff != "" {
+		t.Fatal(diff)
+	}
+}
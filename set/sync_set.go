@@ -0,0 +1,57 @@
+package set
+
+import "sync"
+
+// NewSync creates a new SyncSet.
+func NewSync[T comparable]() *SyncSet[T] {
+	return &SyncSet[T]{
+		set: New[T](),
+	}
+}
+
+// SyncSet is a Set which is safe for concurrent use by multiple goroutines.
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *Set[T]
+}
+
+// Len returns the size of the set.
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Len()
+}
+
+// Insert inserts a new value into the set.
+func (s *SyncSet[T]) Insert(val T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Insert(val)
+}
+
+// Delete deletes a key from a set. If key doesn't exist, it's a no-op.
+func (s *SyncSet[T]) Delete(val T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Delete(val)
+}
+
+// Has checks whether the set contains the given value or not.
+func (s *SyncSet[T]) Has(val T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(val)
+}
+
+// Scan scans through the set in an arbitrary order.
+// The set is read-locked during the scan, so itor must not modify the set.
+func (s *SyncSet[T]) Scan(itor func(val T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.set.Scan(itor)
+}
+
+// Empty returns whether the set is empty or not.
+func (s *SyncSet[T]) Empty() bool {
+	return s.Len() == 0
+}
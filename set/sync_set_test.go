@@ -0,0 +1,45 @@
+package set_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bongnv/go-container/set"
+)
+
+func TestSyncSet(t *testing.T) {
+	t.Run("sync set should work concurrently", func(t *testing.T) {
+		s := set.NewSync[int]()
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					s.Insert(g*100 + i)
+					s.Has(i)
+					s.Len()
+					s.Scan(func(val int) bool {
+						return val%7 != 0
+					})
+				}
+				for i := 0; i < 50; i++ {
+					s.Delete(g*100 + i)
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		if s.Len() != 400 {
+			t.Fatalf("Incorrect size: %v", s.Len())
+		}
+		for g := 0; g < 8; g++ {
+			if s.Has(g*100) || !s.Has(g*100+50) {
+				t.Fatalf("unexpected values for goroutine %v", g)
+			}
+		}
+		if s.Empty() {
+			t.Fatalf("expected a non-empty set")
+		}
+	})
+}
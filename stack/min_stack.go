@@ -0,0 +1,54 @@
+package stack
+
+import "cmp"
+
+// NewMin creates a new MinStack.
+func NewMin[T cmp.Ordered]() *MinStack[T] {
+	return &MinStack[T]{
+		values: New[T](),
+		mins:   New[T](),
+	}
+}
+
+// MinStack is a stack which also tracks the minimum of its values in O(1).
+type MinStack[T cmp.Ordered] struct {
+	values *Stack[T]
+	// mins holds the minimum of values at each push, its top is the minimum of values.
+	mins *Stack[T]
+}
+
+// Len returns the size of the stack.
+func (s *MinStack[T]) Len() int {
+	return s.values.Len()
+}
+
+// Push pushes a value into the stack.
+func (s *MinStack[T]) Push(value T) {
+	s.values.Push(value)
+	if !s.mins.Empty() && cmp.Less(s.mins.Top(), value) {
+		value = s.mins.Top()
+	}
+	s.mins.Push(value)
+}
+
+// Pop pops a value from the stack.
+func (s *MinStack[T]) Pop() T {
+	s.mins.Pop()
+	return s.values.Pop()
+}
+
+// Top returns the value at the top of the stack.
+func (s *MinStack[T]) Top() T {
+	return s.values.Top()
+}
+
+// Min returns the minimum value of the stack.
+// NaN is considered less than any other value like cmp.Less.
+func (s *MinStack[T]) Min() T {
+	return s.mins.Top()
+}
+
+// Empty returns whether the stack is empty or not.
+func (s *MinStack[T]) Empty() bool {
+	return s.Len() == 0
+}
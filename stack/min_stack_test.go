@@ -0,0 +1,73 @@
+package stack_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/bongnv/go-container/stack"
+)
+
+func TestMinStack(t *testing.T) {
+	t.Run("min stack should track the minimum properly", func(t *testing.T) {
+		h := stack.NewMin[int]()
+		steps := []struct {
+			push        *int
+			expectedMin int
+		}{
+			{push: ptr(5), expectedMin: 5},
+			{push: ptr(3), expectedMin: 3},
+			{push: ptr(7), expectedMin: 3},
+			{push: ptr(3), expectedMin: 3},
+			{expectedMin: 3}, // pop 3
+			{expectedMin: 3}, // pop 7
+			{push: ptr(1), expectedMin: 1},
+			{expectedMin: 3}, // pop 1
+			{expectedMin: 5}, // pop 3
+		}
+		for i, step := range steps {
+			if step.push != nil {
+				h.Push(*step.push)
+				if h.Top() != *step.push {
+					t.Fatalf("step %v: expected top %v but got %v", i, *step.push, h.Top())
+				}
+			} else {
+				h.Pop()
+			}
+			if h.Min() != step.expectedMin {
+				t.Fatalf("step %v: expected min %v but got %v", i, step.expectedMin, h.Min())
+			}
+		}
+
+		if v := h.Pop(); v != 5 || !h.Empty() {
+			t.Fatalf("expected 5 but got %v", v)
+		}
+	})
+}
+
+func ptr(v int) *int {
+	return &v
+}
+
+func TestMinStack_NaN(t *testing.T) {
+	h := stack.NewMin[float64]()
+	h.Push(math.NaN())
+	h.Pop()
+	h.Push(5)
+	if h.Len() != 1 || h.Min() != 5 {
+		t.Fatalf("expected min 5 but got %v", h.Min())
+	}
+
+	h.Push(math.NaN())
+	if !math.IsNaN(h.Min()) {
+		t.Fatalf("expected min NaN but got %v", h.Min())
+	}
+	h.Push(1)
+	if !math.IsNaN(h.Min()) {
+		t.Fatalf("expected min NaN but got %v", h.Min())
+	}
+	h.Pop()
+	h.Pop()
+	if h.Min() != 5 {
+		t.Fatalf("expected min 5 but got %v", h.Min())
+	}
+}
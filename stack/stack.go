@@ -41,3 +41,13 @@ func (s *Stack[T]) Top() T {
 func (s *Stack[T]) Empty() bool {
 	return s.Len() == 0
 }
+
+// Clear removes all values from the stack.
+func (s *Stack[T]) Clear() {
+	s.container.Clear()
+}
+
+// Reserve is a hint that the stack will hold at least n values.
+// It does nothing today because the stack is backed by a linked list,
+// which allocates per value and has no capacity to grow.
+func (s *Stack[T]) Reserve(n int) {}
@@ -27,4 +27,32 @@ func TestStack(t *testing.T) {
 			t.Fatalf("expected 2 but got %v", h.Len())
 		}
 	})
+	t.Run("stack should be empty after clearing", func(t *testing.T) {
+		h := stack.New[int]()
+		h.Push(1)
+		h.Push(2)
+		h.Clear()
+		if !h.Empty() {
+			t.Fatalf("expected empty stack but got %v items", h.Len())
+		}
+
+		h.Push(3)
+		if h.Top() != 3 || h.Len() != 1 {
+			t.Fatalf("expected 3 but got %v", h.Top())
+		}
+	})
+	t.Run("stack should be unchanged by reserving", func(t *testing.T) {
+		h := stack.New[int]()
+		h.Push(1)
+		h.Push(2)
+		h.Reserve(10)
+		if h.Len() != 2 {
+			t.Fatalf("expected 2 but got %v", h.Len())
+		}
+		for _, expected := range []int{2, 1} {
+			if v := h.Pop(); v != expected {
+				t.Fatalf("expected %v but got %v", expected, v)
+			}
+		}
+	})
 }
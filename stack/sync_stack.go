@@ -0,0 +1,71 @@
+package stack
+
+import "sync"
+
+// NewSync creates a new SyncStack.
+func NewSync[T any]() *SyncStack[T] {
+	return &SyncStack[T]{
+		stack: New[T](),
+	}
+}
+
+// SyncStack is a Stack which is safe for concurrent use by multiple goroutines.
+type SyncStack[T any] struct {
+	mu    sync.Mutex
+	stack *Stack[T]
+}
+
+// Len returns the size of the stack.
+func (s *SyncStack[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Len()
+}
+
+// Push pushes a value into the stack.
+func (s *SyncStack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(value)
+}
+
+// Pop pops a value from the stack. The stack must not be empty.
+func (s *SyncStack[T]) Pop() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// Top returns the value at the top of the stack. The stack must not be empty.
+func (s *SyncStack[T]) Top() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Top()
+}
+
+// TryPop pops a value from the stack if the stack isn't empty.
+// It returns false if the stack is empty.
+func (s *SyncStack[T]) TryPop() (value T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stack.Empty() {
+		return
+	}
+	return s.stack.Pop(), true
+}
+
+// TryTop returns the value at the top of the stack if the stack isn't empty.
+// It returns false if the stack is empty.
+func (s *SyncStack[T]) TryTop() (value T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stack.Empty() {
+		return
+	}
+	return s.stack.Top(), true
+}
+
+// Empty returns whether the stack is empty or not.
+func (s *SyncStack[T]) Empty() bool {
+	return s.Len() == 0
+}
@@ -0,0 +1,64 @@
+package stack_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bongnv/go-container/stack"
+)
+
+func TestSyncStack(t *testing.T) {
+	t.Run("sync stack should work concurrently", func(t *testing.T) {
+		s := stack.NewSync[int]()
+		goroutines, n := 8, 1000
+
+		var wg sync.WaitGroup
+		popped := make([]int, goroutines)
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < n; i++ {
+					s.Push(i)
+					s.TryTop()
+					if i%2 == 0 {
+						if _, ok := s.TryPop(); ok {
+							popped[g]++
+						}
+					}
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		total := 0
+		for _, p := range popped {
+			total += p
+		}
+		if s.Len() != goroutines*n-total {
+			t.Fatalf("expected %v but got %v", goroutines*n-total, s.Len())
+		}
+	})
+
+	t.Run("sync stack should report empty stacks", func(t *testing.T) {
+		s := stack.NewSync[int]()
+		if _, ok := s.TryPop(); ok {
+			t.Fatalf("expected pop to fail on an empty stack")
+		}
+		if _, ok := s.TryTop(); ok {
+			t.Fatalf("expected top to fail on an empty stack")
+		}
+
+		s.Push(1)
+		s.Push(2)
+		if v, ok := s.TryTop(); !ok || v != 2 || s.Top() != 2 {
+			t.Fatalf("expected 2 but got %v", v)
+		}
+		if v := s.Pop(); v != 2 {
+			t.Fatalf("expected 2 but got %v", v)
+		}
+		if v, ok := s.TryPop(); !ok || v != 1 || !s.Empty() {
+			t.Fatalf("expected 1 but got %v", v)
+		}
+	})
+}